@@ -136,6 +136,11 @@ func (kv KVector) Plus(rightIn interface{}) Expression {
 
 		return VecDenseToKVector(result)
 
+	case SparseKVector:
+		// Defer to SparseKVector.Plus so that a sparse right-hand side never
+		// forces an unnecessary dense allocation on its side of the sum.
+		return SparseKVectorFromDense(kv).Plus(right)
+
 	case VariableVector:
 		return right.Plus(kv)
 
@@ -155,7 +160,9 @@ func (kv KVector) Plus(rightIn interface{}) Expression {
 LessEq
 Description:
 
-	Returns a less than or equal to (<=) constraint between the current expression and another
+	Returns a less than or equal to (<=) constraint between the current
+	expression and another. Use Indicate to tag the result as
+	indicator-linked rather than hard.
 */
 func (kv KVector) LessEq(rightIn interface{}) Constraint {
 	return kv.Comparison(rightIn, SenseLessThanEqual)
@@ -165,7 +172,9 @@ func (kv KVector) LessEq(rightIn interface{}) Constraint {
 GreaterEq
 Description:
 
-	This method returns a greater than or equal to (>=) constraint between the current expression and another
+	This method returns a greater than or equal to (>=) constraint between
+	the current expression and another. Use Indicate to tag the result as
+	indicator-linked rather than hard.
 */
 func (kv KVector) GreaterEq(rightIn interface{}) Constraint {
 	return kv.Comparison(rightIn, SenseGreaterThanEqual)
@@ -175,12 +184,22 @@ func (kv KVector) GreaterEq(rightIn interface{}) Constraint {
 Eq
 Description:
 
-	This method returns an equality (==) constraint between the current expression and another
+	This method returns an equality (==) constraint between the current
+	expression and another. Use Indicate to tag the result as
+	indicator-linked rather than hard.
 */
 func (kv KVector) Eq(rightIn interface{}) Constraint {
 	return kv.Comparison(rightIn, SenseEqual)
 }
 
+/*
+Comparison
+Description:
+
+	Builds a vector-valued comparison between kv and rightIn. The result is
+	always a "hard" (always enforced) Constraint; wrap it with Indicate to
+	tag it as indicator-linked instead.
+*/
 func (kv KVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
 	// Input Checking
 	err := kv.Check()
@@ -202,6 +221,7 @@ func (kv KVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint
 		}
 	}
 
+	var constr Constraint
 	switch rhsConverted := rightIn.(type) {
 	case KVector:
 		// Check Lengths
@@ -215,16 +235,16 @@ func (kv KVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint
 			)
 		}
 
-		// Return constraint
-		return VectorConstraint{
+		// Build constraint
+		constr = VectorConstraint{
 			LeftHandSide:  kv,
 			RightHandSide: rhsConverted,
 			Sense:         sense,
 		}
 
 	case VariableVector:
-		// Return constraint
-		return rhsConverted.Comparison(kv, sense)
+		// Build constraint
+		constr = rhsConverted.Comparison(kv, sense)
 
 	default:
 		// Return an error
@@ -236,6 +256,8 @@ func (kv KVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint
 		)
 
 	}
+
+	return constr
 }
 
 /*
@@ -275,13 +297,58 @@ func (kv KVector) Multiply(rightIn interface{}) Expression {
 
 		return kv.Multiply(eAsFloat)
 
+	case SparseKVector:
+		// Immediately return error, mirroring the KVector/VariableVector cases below:
+		// multiplying two same-length vectors is only defined after a transpose.
+		panic(fmt.Errorf(
+			"dimension mismatch! Cannot multiply KVector with a vector of type %T; Try transposing one or the other!",
+			right,
+		))
+
 	case mat.VecDense:
-		// Send warning until we create matrix type.
-		panic(
-			fmt.Errorf(
-				"MatProInterface does not currently support operations that result in matrices! if you want this feature, create an issue!",
-			),
-		)
+		return kv.Multiply(VecDenseToKVector(right))
+
+	case KMatrix:
+		// Both operands are constant: simplify directly to a KMatrix
+		// rather than falling into the MatrixExpression case below, which
+		// would build a PolynomialMatrix even though every entry is a
+		// plain K.
+		rDims := right.Dims()
+		if rDims[0] != 1 {
+			panic(fmt.Errorf(
+				"cannot multiply KVector of length %v with a matrix expression of dimension %v; expected a row matrix (1 x m) (try transposing it first)",
+				kv.Len(), rDims,
+			))
+		}
+
+		out := ZerosMatrix(kv.Len(), rDims[1])
+		for ii := 0; ii < kv.Len(); ii++ {
+			for jj := 0; jj < rDims[1]; jj++ {
+				out.Set(ii, jj, float64(kv[ii])*right.At(0, jj).Constant())
+			}
+		}
+		return DenseToKMatrix(out)
+
+	case MatrixExpression:
+		// A KVector (n x 1) times a row matrix (1 x m) is an outer product
+		// that produces an n x m matrix.
+		rDims := right.Dims()
+		if rDims[0] != 1 {
+			panic(fmt.Errorf(
+				"cannot multiply KVector of length %v with a matrix expression of dimension %v; expected a row matrix (1 x m) (try transposing it first)",
+				kv.Len(), rDims,
+			))
+		}
+
+		elements := make([][]Polynomial, kv.Len())
+		for ii := 0; ii < kv.Len(); ii++ {
+			elements[ii] = make([]Polynomial, rDims[1])
+			for jj := 0; jj < rDims[1]; jj++ {
+				product := kv[ii].Multiply(right.At(0, jj))
+				elements[ii][jj] = product.(ScalarExpression).ToPolynomial()
+			}
+		}
+		return PolynomialMatrix{Elements: elements}
 
 	case KVector:
 		// Immediately return error.
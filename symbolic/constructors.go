@@ -0,0 +1,136 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+constructors.go
+Description:
+
+	Adds ergonomic, gonum-style builders for the constant vector/matrix
+	types, modeled after mat.NewVecDense/mat.NewDense, plus RawVector/
+	RawMatrix accessors and a fused MulVec kernel. These make translating
+	existing numerical gonum code (e.g. the optimize/BFGS examples) into
+	symbolic form a drop-in replacement rather than a rewrite.
+*/
+
+/*
+NewKVector
+Description:
+
+	Creates a KVector of length n from data, mirroring
+	mat.NewVecDense(n, data). If data is nil, the vector is initialized to
+	all zeros.
+*/
+func NewKVector(n int, data []float64) KVector {
+	if data == nil {
+		data = make([]float64, n)
+	}
+	if len(data) != n {
+		panic(fmt.Errorf(
+			"NewKVector given a length of %v, but data has %v elements",
+			n, len(data),
+		))
+	}
+
+	out := make(KVector, n)
+	for ii, v := range data {
+		out[ii] = K(v)
+	}
+	return out
+}
+
+/*
+NewKVectorFromVec
+Description:
+
+	Creates a KVector from any gonum mat.Vector (e.g. *mat.VecDense).
+*/
+func NewKVectorFromVec(v mat.Vector) KVector {
+	out := make(KVector, v.Len())
+	for ii := 0; ii < v.Len(); ii++ {
+		out[ii] = K(v.AtVec(ii))
+	}
+	return out
+}
+
+/*
+NewKMatrix
+Description:
+
+	Creates a KMatrix of dimension r x c from data (row-major), mirroring
+	mat.NewDense(r, c, data).
+*/
+func NewKMatrix(r, c int, data []float64) KMatrix {
+	dense := mat.NewDense(r, c, data)
+	return DenseToKMatrix(*dense)
+}
+
+/*
+RawVector
+Description:
+
+	Returns the dense mat.VecDense snapshot backing kv. Prefer this over
+	ToVecDense when the intent is to hand kv off to a raw gonum routine,
+	since it reads the same as the rest of the gonum-style constructors
+	above.
+*/
+func (kv KVector) RawVector() mat.VecDense {
+	return kv.ToVecDense()
+}
+
+/*
+RawMatrix
+Description:
+
+	Returns the dense mat.Dense snapshot of km.
+*/
+func (km KMatrix) RawMatrix() mat.Dense {
+	dims := km.Dims()
+	out := ZerosMatrix(dims[0], dims[1])
+	for ii := 0; ii < dims[0]; ii++ {
+		for jj := 0; jj < dims[1]; jj++ {
+			out.Set(ii, jj, km.At(ii, jj).Constant())
+		}
+	}
+	return out
+}
+
+/*
+MulVec
+Description:
+
+	Computes A*x symbolically in one pass: entry i of the result is the dot
+	product of A's i-th row with x. This avoids the pattern where
+	LinearCoeff allocates an n x n identity matrix and Multiply panics on a
+	matrix result; kv is only used to select this method via Go's method
+	resolution and does not otherwise participate in the computation. The
+	result is a PolynomialVector rather than a MonomialVector because a row
+	of A with more than one nonzero entry produces a sum of distinct
+	variable terms, which a Monomial cannot represent.
+*/
+func (kv KVector) MulVec(A mat.Matrix, x VectorExpression) Expression {
+	rows, cols := A.Dims()
+	if cols != x.Len() {
+		panic(fmt.Errorf(
+			"cannot compute MulVec: A has %v columns but x has length %v",
+			cols, x.Len(),
+		))
+	}
+
+	out := make(PolynomialVector, rows)
+	for ii := 0; ii < rows; ii++ {
+		var sum Expression = K(0)
+		for jj := 0; jj < cols; jj++ {
+			if aij := A.At(ii, jj); aij != 0 {
+				sum = sum.Plus(x.AtVec(jj).Multiply(aij))
+			}
+		}
+		out[ii] = sum.(ScalarExpression).ToPolynomial()
+	}
+
+	return out
+}
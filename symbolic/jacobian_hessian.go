@@ -0,0 +1,177 @@
+package symbolic
+
+import "fmt"
+
+/*
+jacobian_hessian.go
+Description:
+
+	Adds Jacobian and Hessian methods to the vector expression types, built on
+	top of each type's existing DerivativeWrt(Variable) Expression method.
+	These give users writing nonlinear optimization problems (e.g. the
+	Newton/BFGS-style solvers in gonum's optimize package) exact symbolic
+	gradients and Hessians instead of relying on finite differences.
+*/
+
+/*
+simplifyToPolynomial
+Description:
+
+	Converts the result of a DerivativeWrt call into a Polynomial, so that
+	Jacobian/Hessian matrices can always be represented as a PolynomialMatrix
+	regardless of which concrete Expression type the derivative produced
+	(e.g. K(0) for a derivative that simplified away to a constant).
+*/
+func simplifyToPolynomial(e Expression) Polynomial {
+	se, ok := e.(ScalarExpression)
+	if !ok {
+		panic(fmt.Errorf(
+			"expected DerivativeWrt to produce a ScalarExpression; received %T",
+			e,
+		))
+	}
+	return se.ToPolynomial()
+}
+
+/*
+Jacobian
+Description:
+
+	Computes the Jacobian of kv with respect to vars, i.e. the m x n matrix
+	whose (i,j) entry is d(kv_i)/d(vars_j). Since a KVector is constant, every
+	entry of this matrix is zero.
+*/
+func (kv KVector) Jacobian(vars []Variable) MatrixExpression {
+	return DenseToKMatrix(ZerosMatrix(kv.Len(), len(vars)))
+}
+
+/*
+Hessian
+Description:
+
+	Computes the Hessian of every entry of kv with respect to vars. Since a
+	KVector is constant, every Hessian is the n x n zero matrix.
+*/
+func (kv KVector) Hessian(vars []Variable) []MatrixExpression {
+	out := make([]MatrixExpression, kv.Len())
+	for ii := range out {
+		out[ii] = DenseToKMatrix(ZerosMatrix(len(vars), len(vars)))
+	}
+	return out
+}
+
+/*
+Jacobian
+Description:
+
+	Computes the Jacobian of vv with respect to vars. Since every entry of a
+	VariableVector is linear in (at most) one variable, each entry of the
+	Jacobian is either K(0) or K(1).
+*/
+func (vv VariableVector) Jacobian(vars []Variable) MatrixExpression {
+	m, n := vv.Len(), len(vars)
+	jac := ZerosMatrix(m, n)
+	for ii := 0; ii < m; ii++ {
+		for jj := 0; jj < n; jj++ {
+			deriv := vv.Elements[ii].DerivativeWrt(vars[jj])
+			jac.Set(ii, jj, float64(deriv.(K)))
+		}
+	}
+	return DenseToKMatrix(jac)
+}
+
+/*
+Hessian
+Description:
+
+	Computes the Hessian of every entry of vv with respect to vars. Since
+	every entry of a VariableVector is linear, every Hessian is the n x n
+	zero matrix.
+*/
+func (vv VariableVector) Hessian(vars []Variable) []MatrixExpression {
+	out := make([]MatrixExpression, vv.Len())
+	for ii := range out {
+		out[ii] = DenseToKMatrix(ZerosMatrix(len(vars), len(vars)))
+	}
+	return out
+}
+
+/*
+Jacobian
+Description:
+
+	Computes the Jacobian of mv with respect to vars by differentiating each
+	element of mv with respect to each variable in vars, simplifying the
+	(possibly zero) result down to a Polynomial.
+*/
+func (mv MonomialVector) Jacobian(vars []Variable) MatrixExpression {
+	m, n := mv.Len(), len(vars)
+	elements := make([][]Polynomial, m)
+	for ii := 0; ii < m; ii++ {
+		elements[ii] = make([]Polynomial, n)
+		for jj := 0; jj < n; jj++ {
+			elements[ii][jj] = simplifyToPolynomial(mv[ii].DerivativeWrt(vars[jj]))
+		}
+	}
+	return PolynomialMatrix{Elements: elements}
+}
+
+/*
+Hessian
+Description:
+
+	Computes the Hessian of every entry of mv with respect to vars, by
+	differentiating its Jacobian a second time.
+*/
+func (mv MonomialVector) Hessian(vars []Variable) []MatrixExpression {
+	return mv.ToPolynomialVector().Hessian(vars)
+}
+
+/*
+Jacobian
+Description:
+
+	Computes the Jacobian of pv with respect to vars, i.e. the m x n
+	PolynomialMatrix whose (i,j) entry is d(pv_i)/d(vars_j). Each entry is
+	computed by calling pv[i].DerivativeWrt(vars[j]) and simplifying zero
+	entries down to K(0).
+*/
+func (pv PolynomialVector) Jacobian(vars []Variable) MatrixExpression {
+	m, n := pv.Len(), len(vars)
+	elements := make([][]Polynomial, m)
+	for ii := 0; ii < m; ii++ {
+		elements[ii] = make([]Polynomial, n)
+		for jj := 0; jj < n; jj++ {
+			elements[ii][jj] = simplifyToPolynomial(pv[ii].DerivativeWrt(vars[jj]))
+		}
+	}
+	return PolynomialMatrix{Elements: elements}
+}
+
+/*
+Hessian
+Description:
+
+	Computes the Hessian of every entry of pv with respect to vars, returning
+	a length-m slice of n x n symmetric PolynomialMatrix objects. Each one is
+	obtained by differentiating the corresponding row of pv's Jacobian a
+	second time.
+*/
+func (pv PolynomialVector) Hessian(vars []Variable) []MatrixExpression {
+	jac := pv.Jacobian(vars).(PolynomialMatrix)
+	n := len(vars)
+
+	out := make([]MatrixExpression, pv.Len())
+	for ii := 0; ii < pv.Len(); ii++ {
+		rowElements := make([][]Polynomial, n)
+		for kk := 0; kk < n; kk++ {
+			rowElements[kk] = make([]Polynomial, n)
+			for jj := 0; jj < n; jj++ {
+				rowElements[kk][jj] = simplifyToPolynomial(jac.Elements[ii][kk].DerivativeWrt(vars[jj]))
+			}
+		}
+		out[ii] = PolynomialMatrix{Elements: rowElements}
+	}
+
+	return out
+}
@@ -0,0 +1,54 @@
+package symbolic
+
+import "fmt"
+
+/*
+matrix_constraint.go
+Description:
+
+	Defines MatrixConstraint, the matrix-valued analogue of VectorConstraint,
+	used when both sides of a comparison are MatrixExpression objects (e.g.
+	the result of SparseKMatrix.Comparison).
+*/
+
+/*
+MatrixConstraint
+Description:
+
+	Represents a constraint of the form LeftHandSide <sense> RightHandSide,
+	where both sides are matrix-valued expressions.
+*/
+type MatrixConstraint struct {
+	LeftHandSide  MatrixExpression
+	RightHandSide MatrixExpression
+	Sense         ConstrSense
+}
+
+/*
+Check
+Description:
+
+	Verifies that both sides of the constraint are well-defined and that their
+	dimensions match.
+*/
+func (mc MatrixConstraint) Check() error {
+	err := mc.LeftHandSide.Check()
+	if err != nil {
+		return err
+	}
+
+	err = mc.RightHandSide.Check()
+	if err != nil {
+		return err
+	}
+
+	lDims, rDims := mc.LeftHandSide.Dims(), mc.RightHandSide.Dims()
+	if lDims[0] != rDims[0] || lDims[1] != rDims[1] {
+		return fmt.Errorf(
+			"the left hand side's dimensions (%v) and the right hand side's dimensions (%v) do not match!",
+			lDims, rDims,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package symbolic
+
+import "gonum.org/v1/gonum/mat"
+
+/*
+matrix_expression.go
+Description:
+
+	Defines the MatrixExpression interface, the common contract implemented by
+	every matrix-valued symbolic expression (KMatrix, VariableMatrix,
+	MonomialMatrix, PolynomialMatrix, ...). This mirrors VectorExpression and
+	ScalarExpression, but for expressions whose natural shape is a matrix.
+*/
+
+/*
+MatrixExpression
+Description:
+
+	Represents a matrix-valued expression in the symbolic optimization
+	framework.
+*/
+type MatrixExpression interface {
+	// Dims returns the dimensions ({rows, columns}) of the matrix expression.
+	Dims() []int
+
+	// Check returns an error if the matrix expression is not well-defined.
+	Check() error
+
+	// Variables returns the slice of unique Variable objects used in the expression.
+	Variables() []Variable
+
+	// LinearCoeff returns the coefficients of the Variables in the expression.
+	LinearCoeff() mat.Dense
+
+	// Constant returns the constant additive term of the expression.
+	Constant() mat.Dense
+
+	// Plus adds the matrix expression to another expression-like object.
+	Plus(rightIn interface{}) Expression
+
+	// Multiply computes the product of the matrix expression with another term.
+	Multiply(rightIn interface{}) Expression
+
+	// Transpose returns the transpose of the matrix expression.
+	Transpose() Expression
+
+	// DerivativeWrt computes the entry-wise derivative of the matrix with
+	// respect to vIn and returns the resulting matrix expression.
+	DerivativeWrt(vIn Variable) Expression
+
+	// At returns the scalar expression located at row i, column j.
+	At(i, j int) ScalarExpression
+
+	// RowAt returns the i-th row of the matrix as a VectorExpression.
+	RowAt(i int) VectorExpression
+
+	// ColAt returns the j-th column of the matrix as a VectorExpression.
+	ColAt(j int) VectorExpression
+}
@@ -0,0 +1,215 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+monomial_matrix.go
+Description:
+
+	Implements MonomialMatrix, the matrix-valued analogue of MonomialVector,
+	for a matrix built directly from a grid of Monomials. Matrix-producing
+	operations such as the outer product in KVector.Multiply return a
+	PolynomialMatrix rather than a MonomialMatrix.
+*/
+
+/*
+MonomialMatrix
+
+	Represents a matrix all of whose entries are Monomial objects.
+*/
+type MonomialMatrix struct {
+	Elements [][]Monomial
+}
+
+/*
+Dims
+Description:
+
+	Returns the dimensions ({rows, columns}) of the MonomialMatrix.
+*/
+func (mm MonomialMatrix) Dims() []int {
+	if len(mm.Elements) == 0 {
+		return []int{0, 0}
+	}
+	return []int{len(mm.Elements), len(mm.Elements[0])}
+}
+
+/*
+Check
+Description:
+
+	Verifies that every row of the MonomialMatrix has the same number of
+	columns.
+*/
+func (mm MonomialMatrix) Check() error {
+	if len(mm.Elements) == 0 {
+		return nil
+	}
+
+	nCols := len(mm.Elements[0])
+	for ii, row := range mm.Elements {
+		if len(row) != nCols {
+			return fmt.Errorf(
+				"row %v of the MonomialMatrix has %v columns; expected %v (the length of row 0)",
+				ii, len(row), nCols,
+			)
+		}
+	}
+
+	return nil
+}
+
+/*
+Variables
+Description:
+
+	Returns the slice of unique Variable objects used across every entry of
+	the MonomialMatrix.
+*/
+func (mm MonomialMatrix) Variables() []Variable {
+	var out []Variable
+	seen := make(map[uint64]bool)
+	for _, row := range mm.Elements {
+		for _, entry := range row {
+			for _, v := range entry.Variables() {
+				if !seen[v.ID] {
+					seen[v.ID] = true
+					out = append(out, v)
+				}
+			}
+		}
+	}
+	return out
+}
+
+/*
+At
+Description:
+
+	Returns the Monomial located at row i, column j as a ScalarExpression.
+*/
+func (mm MonomialMatrix) At(i, j int) ScalarExpression {
+	return mm.Elements[i][j]
+}
+
+/*
+RowAt
+Description:
+
+	Returns the i-th row of the MonomialMatrix as a MonomialVector.
+*/
+func (mm MonomialMatrix) RowAt(i int) VectorExpression {
+	row := make(MonomialVector, len(mm.Elements[i]))
+	copy(row, mm.Elements[i])
+	return row
+}
+
+/*
+ColAt
+Description:
+
+	Returns the j-th column of the MonomialMatrix as a MonomialVector.
+*/
+func (mm MonomialMatrix) ColAt(j int) VectorExpression {
+	col := make(MonomialVector, len(mm.Elements))
+	for ii := range mm.Elements {
+		col[ii] = mm.Elements[ii][j]
+	}
+	return col
+}
+
+/*
+Transpose
+Description:
+
+	Returns the transpose of the MonomialMatrix.
+*/
+func (mm MonomialMatrix) Transpose() Expression {
+	dims := mm.Dims()
+	out := make([][]Monomial, dims[1])
+	for jj := 0; jj < dims[1]; jj++ {
+		out[jj] = make([]Monomial, dims[0])
+		for ii := 0; ii < dims[0]; ii++ {
+			out[jj][ii] = mm.Elements[ii][jj]
+		}
+	}
+	return MonomialMatrix{Elements: out}
+}
+
+/*
+ToPolynomialMatrix
+Description:
+
+	Converts mm to the equivalent PolynomialMatrix. This lets the remaining
+	MatrixExpression methods (LinearCoeff, Constant, Plus, Multiply,
+	DerivativeWrt) be implemented once, on PolynomialMatrix, and reused here,
+	the same way MonomialVector.Hessian defers to PolynomialVector.Hessian in
+	jacobian_hessian.go.
+*/
+func (mm MonomialMatrix) ToPolynomialMatrix() PolynomialMatrix {
+	dims := mm.Dims()
+	elements := make([][]Polynomial, dims[0])
+	for ii := 0; ii < dims[0]; ii++ {
+		elements[ii] = make([]Polynomial, dims[1])
+		for jj := 0; jj < dims[1]; jj++ {
+			elements[ii][jj] = mm.Elements[ii][jj].ToPolynomial()
+		}
+	}
+	return PolynomialMatrix{Elements: elements}
+}
+
+/*
+LinearCoeff
+Description:
+
+	Returns the coefficient of every Variable in mm.Variables() against every
+	entry of mm. See PolynomialMatrix.LinearCoeff for the exact layout.
+*/
+func (mm MonomialMatrix) LinearCoeff() mat.Dense {
+	return mm.ToPolynomialMatrix().LinearCoeff()
+}
+
+/*
+Constant
+Description:
+
+	Returns the constant additive term of every entry of mm, in a matrix of
+	the same dimensions as mm.
+*/
+func (mm MonomialMatrix) Constant() mat.Dense {
+	return mm.ToPolynomialMatrix().Constant()
+}
+
+/*
+Plus
+Description:
+
+	Adds mm to another matrix-valued object, entry by entry.
+*/
+func (mm MonomialMatrix) Plus(rightIn interface{}) Expression {
+	return mm.ToPolynomialMatrix().Plus(rightIn)
+}
+
+/*
+Multiply
+Description:
+
+	Computes the product of mm with another term.
+*/
+func (mm MonomialMatrix) Multiply(rightIn interface{}) Expression {
+	return mm.ToPolynomialMatrix().Multiply(rightIn)
+}
+
+/*
+DerivativeWrt
+Description:
+
+	Computes the entry-wise derivative of mm with respect to vIn.
+*/
+func (mm MonomialMatrix) DerivativeWrt(vIn Variable) Expression {
+	return mm.ToPolynomialMatrix().DerivativeWrt(vIn)
+}
@@ -0,0 +1,202 @@
+package symbolic
+
+import "fmt"
+
+/*
+piecewise.go
+Description:
+
+	Adds piecewise and indicator building blocks to the symbolic layer:
+	KVector.Step(), Cond(pred, a, b), Indicate(Constraint, Variable), and
+	Indicator(Constraint), plus a LinearizeIndicators helper that rewrites
+	indicator-linked constraints into pure linear (big-M) form. These let
+	users express disjunctive and piecewise-linear constraints without
+	hand-coding the big-M encoding for every problem. Indicate is a
+	free-standing wrapper rather than an extra parameter on
+	Comparison/LessEq/GreaterEq/Eq so that it applies uniformly to a
+	Constraint built from any expression type (KVector, VariableVector,
+	PolynomialVector, ...), not just the ones in this package.
+*/
+
+/*
+Step
+Description:
+
+	Computes the element-wise Heaviside step function of kv: 1 where the
+	entry is >= 0, 0 otherwise. Since a KVector is constant this can be
+	evaluated immediately, unlike the step of a variable-valued vector (which
+	would require a MILP reformulation via Indicator/LinearizeIndicators).
+*/
+func (kv KVector) Step() KVector {
+	out := make(KVector, kv.Len())
+	for ii, k := range kv {
+		if float64(k) >= 0 {
+			out[ii] = K(1)
+		} else {
+			out[ii] = K(0)
+		}
+	}
+	return out
+}
+
+/*
+Cond
+Description:
+
+	Returns a if pred is "true", b otherwise. pred may be:
+	  - a constant K, resolved immediately (nonzero is true), or
+	  - a binary indicator Variable z, or the Monomial that Indicator()
+	    returns for one, resolved algebraically as z*a + (1-z)*b. Since z is constrained to
+	    {0, 1} by the MILP solver, this selects exactly a when z == 1 and
+	    exactly b when z == 0, with no additional constraints required.
+	Conditioning on any other variable-valued predicate isn't representable
+	this way; tag the underlying inequality with Indicate and pass the
+	fresh indicator Variable here instead.
+*/
+func Cond(pred Expression, a, b Expression) Expression {
+	switch p := pred.(type) {
+	case K:
+		if float64(p) != 0 {
+			return a
+		}
+		return b
+
+	case Variable, Monomial:
+		notP := K(1).Plus(p.Multiply(-1.0))
+		return p.Multiply(a).Plus(notP.Multiply(b))
+
+	default:
+		panic(fmt.Errorf(
+			"Cond only supports a constant or binary-indicator-Variable predicate; received %T (use Indicator/LinearizeIndicators to obtain an indicator Variable for a variable-valued predicate)",
+			pred,
+		))
+	}
+}
+
+/*
+IndicatorConstraint
+Description:
+
+	Pairs a Constraint with the binary Variable that activates it: when
+	Indicator == 1, Constraint must hold; when Indicator == 0, Constraint is
+	relaxed away. Indicate produces these; LinearizeIndicators consumes
+	them to produce the big-M reformulation.
+*/
+type IndicatorConstraint struct {
+	Constraint Constraint
+	Indicator  Variable
+}
+
+/*
+Indicate
+Description:
+
+	Tags constr as indicator-linked, activated by the binary Variable
+	indicator. This is a free function rather than a parameter on
+	Comparison/LessEq/GreaterEq/Eq so that it works uniformly on a
+	Constraint built from any expression type's comparison, not just the
+	ones whose signatures this package controls.
+*/
+func Indicate(constr Constraint, indicator Variable) Constraint {
+	return IndicatorConstraint{Constraint: constr, Indicator: indicator}
+}
+
+/*
+Check
+Description:
+
+	An IndicatorConstraint is well-defined exactly when the Constraint it
+	wraps is, so this delegates to it. This lets IndicatorConstraint be
+	passed to Problem.AddConstraint; Problem.ToStandardForm panics on an
+	IndicatorConstraint, which LinearizeIndicators rewrites into plain
+	constraints.
+*/
+func (ic IndicatorConstraint) Check() error {
+	return ic.Constraint.Check()
+}
+
+/*
+Indicator
+Description:
+
+	Returns the binary indicator Variable linked to constr (as a 0/1-valued
+	Monomial usable elsewhere in the problem, e.g. in an objective penalty
+	or as the predicate to Cond). constr must have been produced by
+	Indicate.
+*/
+func Indicator(constr Constraint) Expression {
+	ic, ok := constr.(IndicatorConstraint)
+	if !ok {
+		panic(fmt.Errorf(
+			"Indicator expects a Constraint produced by Indicate(constr, z); received %T",
+			constr,
+		))
+	}
+	return ic.Indicator.ToMonomial()
+}
+
+/*
+LinearizeIndicators
+Description:
+
+	Rewrites a slice of Constraint objects into pure linear form. Hard
+	constraints (anything that isn't an IndicatorConstraint) pass through
+	unchanged. An indicator-linked constraint LHS <= RHS tagged with
+	indicator z is rewritten using the standard big-M reformulation
+
+		LHS - bigM * (1 - z) <= RHS
+
+	so that the constraint is enforced when z == 1 and vacuous (for any
+	bounded LHS) when z == 0. The slack is added to every entry of LHS.
+	Equality constraints are split into their <= and >= halves before being
+	relaxed the same way.
+*/
+func LinearizeIndicators(constraints []Constraint, bigM float64) []Constraint {
+	var out []Constraint
+
+	for _, constr := range constraints {
+		ic, ok := constr.(IndicatorConstraint)
+		if !ok {
+			// Hard constraint: pass through unchanged.
+			out = append(out, constr)
+			continue
+		}
+
+		slack := K(1).Plus(ic.Indicator.Multiply(-1.0)).Multiply(bigM) // bigM * (1 - z)
+		negSlack := slack.Multiply(-1.0)
+
+		switch vc := ic.Constraint.(type) {
+		case VectorConstraint:
+			// A KVector cannot be added to a scalar expression, so the slack
+			// is repeated into a vector of LHS's length and applied to LHS.
+			n := vc.LeftHandSide.Len()
+			slackVec, negSlackVec := make(PolynomialVector, n), make(PolynomialVector, n)
+			for ii := 0; ii < n; ii++ {
+				slackVec[ii] = slack.(ScalarExpression).ToPolynomial()
+				negSlackVec[ii] = negSlack.(ScalarExpression).ToPolynomial()
+			}
+			lhsLess := vc.LeftHandSide.Plus(negSlackVec).(VectorExpression) // LHS - bigM*(1-z)
+			lhsMore := vc.LeftHandSide.Plus(slackVec).(VectorExpression)    // LHS + bigM*(1-z)
+
+			switch vc.Sense {
+			case SenseEqual:
+				out = append(out,
+					lhsLess.LessEq(vc.RightHandSide),
+					lhsMore.GreaterEq(vc.RightHandSide),
+				)
+			case SenseLessThanEqual:
+				out = append(out, lhsLess.LessEq(vc.RightHandSide))
+			case SenseGreaterThanEqual:
+				out = append(out, lhsMore.GreaterEq(vc.RightHandSide))
+			}
+
+		default:
+			panic(fmt.Errorf(
+				"LinearizeIndicators does not yet support indicator-linked constraints of type %T",
+				ic.Constraint,
+			))
+		}
+	}
+
+	return out
+}
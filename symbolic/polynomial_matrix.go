@@ -0,0 +1,296 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+polynomial_matrix.go
+Description:
+
+	Implements PolynomialMatrix, the matrix-valued analogue of
+	PolynomialVector. This is the most general of the new matrix types and is
+	used as the result of matrix operations (e.g. VariableMatrix.Plus,
+	Jacobian/Hessian construction) whose entries cannot be simplified down to
+	a Monomial.
+*/
+
+/*
+PolynomialMatrix
+
+	Represents a matrix all of whose entries are Polynomial objects.
+*/
+type PolynomialMatrix struct {
+	Elements [][]Polynomial
+}
+
+/*
+Dims
+Description:
+
+	Returns the dimensions ({rows, columns}) of the PolynomialMatrix.
+*/
+func (pm PolynomialMatrix) Dims() []int {
+	if len(pm.Elements) == 0 {
+		return []int{0, 0}
+	}
+	return []int{len(pm.Elements), len(pm.Elements[0])}
+}
+
+/*
+Check
+Description:
+
+	Verifies that every row of the PolynomialMatrix has the same number of
+	columns.
+*/
+func (pm PolynomialMatrix) Check() error {
+	if len(pm.Elements) == 0 {
+		return nil
+	}
+
+	nCols := len(pm.Elements[0])
+	for ii, row := range pm.Elements {
+		if len(row) != nCols {
+			return fmt.Errorf(
+				"row %v of the PolynomialMatrix has %v columns; expected %v (the length of row 0)",
+				ii, len(row), nCols,
+			)
+		}
+	}
+
+	return nil
+}
+
+/*
+Variables
+Description:
+
+	Returns the slice of unique Variable objects used across every entry of
+	the PolynomialMatrix.
+*/
+func (pm PolynomialMatrix) Variables() []Variable {
+	var out []Variable
+	seen := make(map[uint64]bool)
+	for _, row := range pm.Elements {
+		for _, entry := range row {
+			for _, v := range entry.Variables() {
+				if !seen[v.ID] {
+					seen[v.ID] = true
+					out = append(out, v)
+				}
+			}
+		}
+	}
+	return out
+}
+
+/*
+At
+Description:
+
+	Returns the Polynomial located at row i, column j as a ScalarExpression.
+*/
+func (pm PolynomialMatrix) At(i, j int) ScalarExpression {
+	return pm.Elements[i][j]
+}
+
+/*
+RowAt
+Description:
+
+	Returns the i-th row of the PolynomialMatrix as a PolynomialVector.
+*/
+func (pm PolynomialMatrix) RowAt(i int) VectorExpression {
+	row := make(PolynomialVector, len(pm.Elements[i]))
+	copy(row, pm.Elements[i])
+	return row
+}
+
+/*
+ColAt
+Description:
+
+	Returns the j-th column of the PolynomialMatrix as a PolynomialVector.
+*/
+func (pm PolynomialMatrix) ColAt(j int) VectorExpression {
+	col := make(PolynomialVector, len(pm.Elements))
+	for ii := range pm.Elements {
+		col[ii] = pm.Elements[ii][j]
+	}
+	return col
+}
+
+/*
+Transpose
+Description:
+
+	Returns the transpose of the PolynomialMatrix.
+*/
+func (pm PolynomialMatrix) Transpose() Expression {
+	dims := pm.Dims()
+	out := make([][]Polynomial, dims[1])
+	for jj := 0; jj < dims[1]; jj++ {
+		out[jj] = make([]Polynomial, dims[0])
+		for ii := 0; ii < dims[0]; ii++ {
+			out[jj][ii] = pm.Elements[ii][jj]
+		}
+	}
+	return PolynomialMatrix{Elements: out}
+}
+
+/*
+LinearCoeff
+Description:
+
+	Returns the coefficient of every Variable in pm.Variables() against every
+	entry of pm, flattened row-major: row (i*cols+j) of the result holds the
+	linear coefficients of pm.Elements[i][j].
+*/
+func (pm PolynomialMatrix) LinearCoeff() mat.Dense {
+	dims := pm.Dims()
+	vars := pm.Variables()
+
+	out := ZerosMatrix(dims[0]*dims[1], len(vars))
+	idx := 0
+	for ii := 0; ii < dims[0]; ii++ {
+		for jj := 0; jj < dims[1]; jj++ {
+			for kk, v := range vars {
+				deriv := pm.Elements[ii][jj].DerivativeWrt(v)
+				out.Set(idx, kk, deriv.(ScalarExpression).Constant())
+			}
+			idx++
+		}
+	}
+	return out
+}
+
+/*
+Constant
+Description:
+
+	Returns the constant additive term of every entry of pm, in a matrix of
+	the same dimensions as pm.
+*/
+func (pm PolynomialMatrix) Constant() mat.Dense {
+	dims := pm.Dims()
+	out := ZerosMatrix(dims[0], dims[1])
+	for ii := 0; ii < dims[0]; ii++ {
+		for jj := 0; jj < dims[1]; jj++ {
+			out.Set(ii, jj, pm.Elements[ii][jj].Constant())
+		}
+	}
+	return out
+}
+
+/*
+Plus
+Description:
+
+	Adds the PolynomialMatrix to another matrix-valued object, entry by
+	entry.
+*/
+func (pm PolynomialMatrix) Plus(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case PolynomialMatrix:
+		dims1, dims2 := pm.Dims(), right.Dims()
+		if dims1[0] != dims2[0] || dims1[1] != dims2[1] {
+			panic(fmt.Errorf(
+				"cannot add PolynomialMatrix of dimension %v to one of dimension %v",
+				dims1, dims2,
+			))
+		}
+
+		elements := make([][]Polynomial, dims1[0])
+		for ii := range elements {
+			elements[ii] = make([]Polynomial, dims1[1])
+			for jj := range elements[ii] {
+				sum := pm.Elements[ii][jj].Plus(right.Elements[ii][jj])
+				elements[ii][jj] = sum.(ScalarExpression).ToPolynomial()
+			}
+		}
+		return PolynomialMatrix{Elements: elements}
+
+	case MonomialMatrix:
+		return pm.Plus(right.ToPolynomialMatrix())
+
+	default:
+		panic(fmt.Errorf(
+			"unrecognized right-hand side type %T for PolynomialMatrix.Plus(%v)!",
+			right, right,
+		))
+	}
+}
+
+/*
+Multiply
+Description:
+
+	Computes the product of the PolynomialMatrix with a compatible KVector
+	(producing a PolynomialVector of row-wise dot products) or scales every
+	entry by a scalar.
+*/
+func (pm PolynomialMatrix) Multiply(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case KVector:
+		dims := pm.Dims()
+		if dims[1] != right.Len() {
+			panic(fmt.Errorf(
+				"cannot multiply PolynomialMatrix of dimension %v with KVector of length %v",
+				dims, right.Len(),
+			))
+		}
+
+		out := make(PolynomialVector, dims[0])
+		for ii := 0; ii < dims[0]; ii++ {
+			var sum Expression = K(0)
+			for jj := 0; jj < dims[1]; jj++ {
+				sum = sum.Plus(pm.Elements[ii][jj].Multiply(right[jj]))
+			}
+			out[ii] = sum.(ScalarExpression).ToPolynomial()
+		}
+		return out
+
+	case float64:
+		dims := pm.Dims()
+		elements := make([][]Polynomial, dims[0])
+		for ii := 0; ii < dims[0]; ii++ {
+			elements[ii] = make([]Polynomial, dims[1])
+			for jj := 0; jj < dims[1]; jj++ {
+				product := pm.Elements[ii][jj].Multiply(right)
+				elements[ii][jj] = product.(ScalarExpression).ToPolynomial()
+			}
+		}
+		return PolynomialMatrix{Elements: elements}
+
+	case K:
+		return pm.Multiply(float64(right))
+
+	default:
+		panic(fmt.Errorf(
+			"unrecognized right-hand side type %T for PolynomialMatrix.Multiply(%v)!",
+			right, right,
+		))
+	}
+}
+
+/*
+DerivativeWrt
+Description:
+
+	Computes the entry-wise derivative of the PolynomialMatrix with respect
+	to vIn.
+*/
+func (pm PolynomialMatrix) DerivativeWrt(vIn Variable) Expression {
+	dims := pm.Dims()
+	elements := make([][]Polynomial, dims[0])
+	for ii := 0; ii < dims[0]; ii++ {
+		elements[ii] = make([]Polynomial, dims[1])
+		for jj := 0; jj < dims[1]; jj++ {
+			elements[ii][jj] = simplifyToPolynomial(pm.Elements[ii][jj].DerivativeWrt(vIn))
+		}
+	}
+	return PolynomialMatrix{Elements: elements}
+}
@@ -0,0 +1,363 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+problem.go
+Description:
+
+	Adds a Problem subsystem to the symbolic package: a thin container for an
+	objective and a list of constraints, plus a ToStandardForm method that
+	walks the expression tree and reduces it to a canonical
+	(c, A_eq, b_eq, A_ineq, b_ineq, Q_i, cone_types) form suitable for handing
+	to an external solver. This closes the loop between symbolic expressions
+	and solver back-ends without requiring every caller to reimplement
+	affine/quadratic extraction.
+*/
+
+/*
+ProblemClass
+Description:
+
+	Classifies a Problem according to the structure of its objective and
+	constraints.
+*/
+type ProblemClass int
+
+const (
+	ProblemClassUnknown ProblemClass = iota
+	ProblemClassLP                   // Linear program: linear objective, linear constraints.
+	ProblemClassQP                   // Quadratic program: quadratic objective, linear constraints.
+	ProblemClassQCQP                 // Quadratically-constrained quadratic program.
+	ProblemClassSOCP                 // Second-order cone program. Reserved: ToStandardForm never produces this today; see ConeTypeSecondOrder.
+	ProblemClassSDP                  // Semidefinite program.
+)
+
+func (pc ProblemClass) String() string {
+	switch pc {
+	case ProblemClassLP:
+		return "LP"
+	case ProblemClassQP:
+		return "QP"
+	case ProblemClassQCQP:
+		return "QCQP"
+	case ProblemClassSOCP:
+		return "SOCP"
+	case ProblemClassSDP:
+		return "SDP"
+	default:
+		return "Unknown"
+	}
+}
+
+/*
+ConeType
+Description:
+
+	Identifies the cone that a row (or block of rows) of the standard-form
+	inequality system belongs to.
+*/
+type ConeType int
+
+const (
+	ConeTypeNonnegative ConeType = iota
+	// ConeTypeSecondOrder is reserved for a future norm-cone Constraint
+	// type; ToStandardForm does not produce it today because this package
+	// has no such constraint to classify.
+	ConeTypeSecondOrder
+	ConeTypePSD
+)
+
+/*
+StandardForm
+Description:
+
+	The canonical conic-form reduction of a Problem: minimize c^T x subject
+	to A_eq x = b_eq, A_ineq x <=_K b_ineq (where <=_K is taken cone-by-cone
+	according to ConeTypes), plus quadratic coefficients Q_i for the
+	objective (first, when it is quadratic) and for each ScalarConstraint
+	that is not purely linear. Column jj of C, AEq and AIneq belongs to
+	Vars[jj].
+*/
+type StandardForm struct {
+	Vars      []Variable
+	C         mat.VecDense
+	AEq       mat.Dense
+	BEq       mat.VecDense
+	AIneq     mat.Dense
+	BIneq     mat.VecDense
+	Q         []mat.SymDense
+	ConeTypes []ConeType
+	Class     ProblemClass
+}
+
+/*
+Problem
+Description:
+
+	Represents a mathematical program: an objective to minimize, subject to
+	a list of constraints.
+*/
+type Problem struct {
+	Objective   Expression
+	Constraints []Constraint
+}
+
+/*
+NewProblem
+Description:
+
+	Creates a new, empty Problem with no objective and no constraints.
+*/
+func NewProblem() *Problem {
+	return &Problem{
+		Objective:   K(0),
+		Constraints: []Constraint{},
+	}
+}
+
+/*
+Minimize
+Description:
+
+	Sets the Problem's objective to expr. expr must be a ScalarExpression.
+*/
+func (p *Problem) Minimize(expr Expression) {
+	if _, ok := expr.(ScalarExpression); !ok {
+		panic(fmt.Errorf(
+			"Problem.Minimize expects a ScalarExpression objective; received %T",
+			expr,
+		))
+	}
+	p.Objective = expr
+}
+
+/*
+AddConstraint
+Description:
+
+	Appends constr to the Problem's list of constraints.
+*/
+func (p *Problem) AddConstraint(constr Constraint) {
+	p.Constraints = append(p.Constraints, constr)
+}
+
+/*
+QuadraticCoeff
+Description:
+
+	Returns the quadratic coefficient matrix Q (with respect to the ordering
+	given by vars) such that the degree-2 part of expr can be written as
+	x^T Q x. This is computed from expr's Hessian, since for a quadratic
+	expression the Hessian is the constant matrix 2Q.
+*/
+func QuadraticCoeff(expr Expression, vars []Variable) mat.SymDense {
+	se, ok := expr.(ScalarExpression)
+	if !ok {
+		panic(fmt.Errorf("QuadraticCoeff expects a ScalarExpression; received %T", expr))
+	}
+
+	pv := PolynomialVector{se.ToPolynomial()}
+	hessian := pv.Hessian(vars)[0]
+
+	n := len(vars)
+	data := make([]float64, n*n)
+	for ii := 0; ii < n; ii++ {
+		for jj := 0; jj < n; jj++ {
+			data[ii*n+jj] = hessian.At(ii, jj).Constant() / 2.0
+		}
+	}
+
+	return *mat.NewSymDense(n, data)
+}
+
+/*
+isLinear
+Description:
+
+	Returns true if expr's quadratic coefficient matrix (with respect to
+	vars) is identically zero.
+*/
+func isLinear(expr Expression, vars []Variable) bool {
+	Q := QuadraticCoeff(expr, vars)
+	n, _ := Q.Dims()
+	for ii := 0; ii < n; ii++ {
+		for jj := 0; jj < n; jj++ {
+			if Q.At(ii, jj) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/*
+ToStandardForm
+Description:
+
+	Walks the expression tree of the objective and every constraint and
+	reduces the Problem to a canonical StandardForm, classifying it as
+	LP / QP / QCQP / SDP along the way (ProblemClassSOCP is never produced).
+
+	Classification proceeds as follows: a MatrixConstraint anywhere in the
+	problem (e.g. a PSD requirement on a VariableMatrix) makes the problem an
+	SDP; otherwise, any quadratic constraint makes it a QCQP; a quadratic
+	objective with linear constraints makes it a QP; a fully linear
+	objective and constraint set makes it an LP. A MatrixConstraint only
+	sets the class: it adds no rows, no variables and no cone type to the
+	result.
+*/
+func (p *Problem) ToStandardForm() StandardForm {
+	vars := p.Objective.Variables()
+	seen := make(map[uint64]bool)
+	for _, v := range vars {
+		seen[v.ID] = true
+	}
+	for _, constr := range p.Constraints {
+		var constrVars []Variable
+		switch c := constr.(type) {
+		case VectorConstraint:
+			constrVars = c.LeftHandSide.Variables()
+		case ScalarConstraint:
+			constrVars = c.LeftHandSide.Variables()
+		}
+		for _, v := range constrVars {
+			if !seen[v.ID] {
+				seen[v.ID] = true
+				vars = append(vars, v)
+			}
+		}
+	}
+	n := len(vars)
+
+	// Objective: c^T x (+ quadratic part, captured separately).
+	c := *mat.NewVecDense(n, nil)
+	objectiveIsQuadratic := false
+	if se, ok := p.Objective.(ScalarExpression); ok {
+		if !isLinear(se, vars) {
+			objectiveIsQuadratic = true
+		}
+		grad := PolynomialVector{se.ToPolynomial()}.Jacobian(vars)
+		for jj := 0; jj < n; jj++ {
+			c.SetVec(jj, grad.At(0, jj).Constant())
+		}
+	}
+
+	var Qs []mat.SymDense
+	if objectiveIsQuadratic {
+		Qs = append(Qs, QuadraticCoeff(p.Objective, vars))
+	}
+
+	// Constraints: split into equality/inequality rows, tracking cone type
+	// and whether any inequality constraint is quadratic.
+	var aEqRows, aIneqRows [][]float64
+	var bEq, bIneq []float64
+	var coneTypes []ConeType
+	hasMatrixConstraint := false
+	hasQuadraticConstraint := false
+
+	for _, constr := range p.Constraints {
+		switch c := constr.(type) {
+		case VectorConstraint:
+			lhsMinusRhs := c.LeftHandSide.Plus(c.RightHandSide.Multiply(-1.0)).(VectorExpression)
+			constant := lhsMinusRhs.Constant()
+
+			for ii := 0; ii < lhsMinusRhs.Len(); ii++ {
+				// Differentiate against vars so that column jj matches vars[jj].
+				grad := PolynomialVector{lhsMinusRhs.AtVec(ii).ToPolynomial()}.Jacobian(vars)
+				row := make([]float64, n)
+				for jj := 0; jj < n; jj++ {
+					row[jj] = grad.At(0, jj).Constant()
+				}
+
+				switch c.Sense {
+				case SenseEqual:
+					aEqRows = append(aEqRows, row)
+					bEq = append(bEq, -constant.AtVec(ii))
+				default:
+					aIneqRows = append(aIneqRows, row)
+					bIneq = append(bIneq, -constant.AtVec(ii))
+					coneTypes = append(coneTypes, ConeTypeNonnegative)
+				}
+			}
+
+		case ScalarConstraint:
+			lhsMinusRhs := c.LeftHandSide.Plus(c.RightHandSide.Multiply(-1.0)).(ScalarExpression)
+			if !isLinear(lhsMinusRhs, vars) {
+				// Record the constraint's quadratic coefficient in Qs; it
+				// contributes no row to AEq/AIneq and flips the problem's class.
+				Qs = append(Qs, QuadraticCoeff(lhsMinusRhs, vars))
+				hasQuadraticConstraint = true
+				continue
+			}
+
+			grad := PolynomialVector{lhsMinusRhs.ToPolynomial()}.Jacobian(vars)
+			row := make([]float64, n)
+			for jj := 0; jj < n; jj++ {
+				row[jj] = grad.At(0, jj).Constant()
+			}
+			constant := lhsMinusRhs.Constant()
+
+			switch c.Sense {
+			case SenseEqual:
+				aEqRows = append(aEqRows, row)
+				bEq = append(bEq, -constant)
+			default:
+				aIneqRows = append(aIneqRows, row)
+				bIneq = append(bIneq, -constant)
+				coneTypes = append(coneTypes, ConeTypeNonnegative)
+			}
+
+		case MatrixConstraint:
+			hasMatrixConstraint = true
+
+		default:
+			panic(fmt.Errorf("ToStandardForm encountered an unrecognized Constraint type %T", c))
+		}
+	}
+
+	// gonum panics on zero-length dimensions, so an empty block stays the
+	// zero value (Dims() == 0, 0).
+	var aEq, aIneq mat.Dense
+	var bEqVec, bIneqVec mat.VecDense
+	if len(aEqRows) > 0 {
+		aEq = *mat.NewDense(len(aEqRows), n, nil)
+		bEqVec = *mat.NewVecDense(len(bEq), bEq)
+	}
+	for ii, row := range aEqRows {
+		aEq.SetRow(ii, row)
+	}
+	if len(aIneqRows) > 0 {
+		aIneq = *mat.NewDense(len(aIneqRows), n, nil)
+		bIneqVec = *mat.NewVecDense(len(bIneq), bIneq)
+	}
+	for ii, row := range aIneqRows {
+		aIneq.SetRow(ii, row)
+	}
+
+	class := ProblemClassLP
+	switch {
+	case hasMatrixConstraint:
+		class = ProblemClassSDP
+	case hasQuadraticConstraint:
+		class = ProblemClassQCQP
+	case objectiveIsQuadratic:
+		class = ProblemClassQP
+	}
+
+	return StandardForm{
+		Vars:      vars,
+		C:         c,
+		AEq:       aEq,
+		BEq:       bEqVec,
+		AIneq:     aIneq,
+		BIneq:     bIneqVec,
+		Q:         Qs,
+		ConeTypes: coneTypes,
+		Class:     class,
+	}
+}
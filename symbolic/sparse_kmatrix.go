@@ -0,0 +1,387 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+sparse_kmatrix.go
+Description:
+
+	Implements SparseKMatrix, a constant matrix type stored in CSR form
+	(row-major: RowPtr/ColIndices/Values). Triplets (COO) are accepted at
+	construction time and compressed into CSR immediately, mirroring the
+	standard COO-for-construction, CSR/CSC-for-arithmetic convention used by
+	sparse linear algebra libraries.
+*/
+
+/*
+SparseKMatrix
+
+	A constant matrix, like KMatrix, but stored in compressed sparse row
+	(CSR) form.
+*/
+type SparseKMatrix struct {
+	Rows, Cols int
+	RowPtr     []int
+	ColIndices []int
+	Values     []float64
+}
+
+/*
+NewSparseKMatrixFromTriplets
+Description:
+
+	Builds a SparseKMatrix in CSR form from COO triplets (rowIndices[k],
+	colIndices[k], values[k]). Triplets may be given in any order; entries
+	sharing a (row, col) pair are summed.
+*/
+func NewSparseKMatrixFromTriplets(rows, cols int, rowIndices, colIndices []int, values []float64) SparseKMatrix {
+	type entry struct {
+		col int
+		val float64
+	}
+	byRow := make([][]entry, rows)
+	seen := make(map[[2]int]int) // (row,col) -> index into byRow[row]
+
+	for k, r := range rowIndices {
+		c := colIndices[k]
+		key := [2]int{r, c}
+		if idx, ok := seen[key]; ok {
+			byRow[r][idx].val += values[k]
+			continue
+		}
+		byRow[r] = append(byRow[r], entry{col: c, val: values[k]})
+		seen[key] = len(byRow[r]) - 1
+	}
+
+	rowPtr := make([]int, rows+1)
+	var colOut []int
+	var valOut []float64
+	for r := 0; r < rows; r++ {
+		rowPtr[r] = len(colOut)
+		for _, e := range byRow[r] {
+			colOut = append(colOut, e.col)
+			valOut = append(valOut, e.val)
+		}
+	}
+	rowPtr[rows] = len(colOut)
+
+	return SparseKMatrix{Rows: rows, Cols: cols, RowPtr: rowPtr, ColIndices: colOut, Values: valOut}
+}
+
+/*
+Dims
+Description:
+
+	Returns the dimensions ({rows, columns}) of the SparseKMatrix.
+*/
+func (skm SparseKMatrix) Dims() []int {
+	return []int{skm.Rows, skm.Cols}
+}
+
+/*
+NNZ
+Description:
+
+	Returns the number of explicitly-stored nonzero entries.
+*/
+func (skm SparseKMatrix) NNZ() int {
+	return len(skm.Values)
+}
+
+/*
+Check
+Description:
+
+	Verifies that RowPtr has the expected length and that every stored column
+	index is within bounds.
+*/
+func (skm SparseKMatrix) Check() error {
+	if len(skm.RowPtr) != skm.Rows+1 {
+		return fmt.Errorf(
+			"SparseKMatrix has %v rows but RowPtr has length %v (expected %v)",
+			skm.Rows, len(skm.RowPtr), skm.Rows+1,
+		)
+	}
+
+	for _, c := range skm.ColIndices {
+		if c < 0 || c >= skm.Cols {
+			return fmt.Errorf(
+				"SparseKMatrix has a column index %v out of bounds for %v columns",
+				c, skm.Cols,
+			)
+		}
+	}
+
+	return nil
+}
+
+/*
+Variables
+Description:
+
+	Returns the empty slice because no variables are in a constant matrix.
+*/
+func (skm SparseKMatrix) Variables() []Variable {
+	return []Variable{}
+}
+
+/*
+At
+Description:
+
+	Returns the value stored at row i, column j, which is K(0) if not
+	explicitly stored.
+*/
+func (skm SparseKMatrix) At(i, j int) ScalarExpression {
+	for k := skm.RowPtr[i]; k < skm.RowPtr[i+1]; k++ {
+		if skm.ColIndices[k] == j {
+			return K(skm.Values[k])
+		}
+	}
+	return K(0)
+}
+
+/*
+RowAt
+Description:
+
+	Returns the i-th row of skm as a SparseKVector, keeping only that row's
+	explicitly stored entries.
+*/
+func (skm SparseKMatrix) RowAt(i int) VectorExpression {
+	var indices []int
+	var values []float64
+	for k := skm.RowPtr[i]; k < skm.RowPtr[i+1]; k++ {
+		indices = append(indices, skm.ColIndices[k])
+		values = append(values, skm.Values[k])
+	}
+	return SparseKVector{Length: skm.Cols, Indices: indices, Values: values}
+}
+
+/*
+ColAt
+Description:
+
+	Returns the j-th column of skm as a SparseKVector, keeping only that
+	column's explicitly stored entries.
+*/
+func (skm SparseKMatrix) ColAt(j int) VectorExpression {
+	var indices []int
+	var values []float64
+	for i := 0; i < skm.Rows; i++ {
+		for k := skm.RowPtr[i]; k < skm.RowPtr[i+1]; k++ {
+			if skm.ColIndices[k] == j {
+				indices = append(indices, i)
+				values = append(values, skm.Values[k])
+			}
+		}
+	}
+	return SparseKVector{Length: skm.Rows, Indices: indices, Values: values}
+}
+
+/*
+LinearCoeff
+Description:
+
+	Returns the coefficients of the Variables in the expression, flattened
+	row-major so that row (i*Cols+j) holds the linear coefficients of
+	entry (i,j) — the same (rows*cols) x nVars layout PolynomialMatrix.
+	LinearCoeff uses. Since skm is constant and has no Variables, this is
+	always a (Rows*Cols) x 0 matrix.
+*/
+func (skm SparseKMatrix) LinearCoeff() mat.Dense {
+	return ZerosMatrix(skm.Rows*skm.Cols, len(skm.Variables()))
+}
+
+/*
+Constant
+Description:
+
+	Returns the dense constant matrix represented by skm.
+*/
+func (skm SparseKMatrix) Constant() mat.Dense {
+	return skm.ToDense()
+}
+
+/*
+DerivativeWrt
+Description:
+
+	Computes the derivative of skm with respect to vIn, which is always the
+	zero matrix since skm is constant.
+*/
+func (skm SparseKMatrix) DerivativeWrt(vIn Variable) Expression {
+	return SparseKMatrix{Rows: skm.Rows, Cols: skm.Cols, RowPtr: make([]int, skm.Rows+1)}
+}
+
+/*
+ToDense
+Description:
+
+	Converts the SparseKMatrix to a dense mat.Dense.
+*/
+func (skm SparseKMatrix) ToDense() mat.Dense {
+	out := ZerosMatrix(skm.Rows, skm.Cols)
+	for i := 0; i < skm.Rows; i++ {
+		for k := skm.RowPtr[i]; k < skm.RowPtr[i+1]; k++ {
+			out.Set(i, skm.ColIndices[k], skm.Values[k])
+		}
+	}
+	return out
+}
+
+/*
+SparseKMatrixFromDense
+Description:
+
+	Builds a SparseKMatrix from a dense mat.Matrix, keeping only its nonzero
+	entries.
+*/
+func SparseKMatrixFromDense(d mat.Matrix) SparseKMatrix {
+	rows, cols := d.Dims()
+
+	var rowIndices, colIndices []int
+	var values []float64
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if v := d.At(i, j); v != 0 {
+				rowIndices = append(rowIndices, i)
+				colIndices = append(colIndices, j)
+				values = append(values, v)
+			}
+		}
+	}
+
+	return NewSparseKMatrixFromTriplets(rows, cols, rowIndices, colIndices, values)
+}
+
+/*
+Plus
+Description:
+
+	Adds skm to another matrix expression. Adding two SparseKMatrix values
+	preserves sparsity; every other combination falls back to dense addition
+	via DenseToKMatrix.
+*/
+func (skm SparseKMatrix) Plus(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case SparseKMatrix:
+		if skm.Rows != right.Rows || skm.Cols != right.Cols {
+			panic(fmt.Errorf(
+				"cannot add SparseKMatrix of dimension (%v,%v) to one of dimension (%v,%v)",
+				skm.Rows, skm.Cols, right.Rows, right.Cols,
+			))
+		}
+
+		var rowIndices, colIndices []int
+		var values []float64
+		collect := func(m SparseKMatrix) {
+			for i := 0; i < m.Rows; i++ {
+				for k := m.RowPtr[i]; k < m.RowPtr[i+1]; k++ {
+					rowIndices = append(rowIndices, i)
+					colIndices = append(colIndices, m.ColIndices[k])
+					values = append(values, m.Values[k])
+				}
+			}
+		}
+		collect(skm)
+		collect(right)
+
+		return NewSparseKMatrixFromTriplets(skm.Rows, skm.Cols, rowIndices, colIndices, values)
+
+	default:
+		dense := skm.ToDense()
+		return DenseToKMatrix(dense).Plus(rightIn)
+	}
+}
+
+/*
+Multiply
+Description:
+
+	Multiplies skm by another term. Scaling by a scalar preserves sparsity;
+	a SparseKVector is multiplied by walking skm's CSR rows and returns a
+	dense KVector; every other combination falls back to dense
+	multiplication via DenseToKMatrix.
+*/
+func (skm SparseKMatrix) Multiply(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case float64:
+		values := make([]float64, len(skm.Values))
+		for ii, v := range skm.Values {
+			values[ii] = v * right
+		}
+		return SparseKMatrix{
+			Rows: skm.Rows, Cols: skm.Cols,
+			RowPtr:     append([]int{}, skm.RowPtr...),
+			ColIndices: append([]int{}, skm.ColIndices...),
+			Values:     values,
+		}
+
+	case K:
+		return skm.Multiply(float64(right))
+
+	case SparseKVector:
+		if skm.Cols != right.Len() {
+			panic(fmt.Errorf(
+				"cannot multiply SparseKMatrix with %v columns by SparseKVector of length %v",
+				skm.Cols, right.Len(),
+			))
+		}
+
+		dense := right.ToDense()
+		out := ZerosVector(skm.Rows)
+		for i := 0; i < skm.Rows; i++ {
+			var sum float64
+			for k := skm.RowPtr[i]; k < skm.RowPtr[i+1]; k++ {
+				sum += skm.Values[k] * float64(dense[skm.ColIndices[k]])
+			}
+			out.SetVec(i, sum)
+		}
+		return VecDenseToKVector(out)
+
+	default:
+		dense := skm.ToDense()
+		return DenseToKMatrix(dense).Multiply(rightIn)
+	}
+}
+
+/*
+Transpose
+Description:
+
+	Returns the transpose of skm, stored in CSR form (i.e. its CSC
+	representation seen from the transposed matrix's perspective).
+*/
+func (skm SparseKMatrix) Transpose() Expression {
+	var rowIndices, colIndices []int
+	var values []float64
+	for i := 0; i < skm.Rows; i++ {
+		for k := skm.RowPtr[i]; k < skm.RowPtr[i+1]; k++ {
+			rowIndices = append(rowIndices, skm.ColIndices[k])
+			colIndices = append(colIndices, i)
+			values = append(values, skm.Values[k])
+		}
+	}
+	return NewSparseKMatrixFromTriplets(skm.Cols, skm.Rows, rowIndices, colIndices, values)
+}
+
+/*
+Comparison
+Description:
+
+	Creates a MatrixConstraint between skm (converted to dense form) and
+	rightIn.
+*/
+func (skm SparseKMatrix) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
+	dense := DenseToKMatrix(skm.ToDense())
+	return MatrixConstraint{
+		LeftHandSide:  dense,
+		RightHandSide: rightIn.(MatrixExpression),
+		Sense:         sense,
+	}
+}
@@ -0,0 +1,332 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+sparse_kvector.go
+Description:
+
+	Implements SparseKVector, a constant vector type that stores only its
+	nonzero entries (COO-style: parallel Indices/Values slices). This avoids
+	the dense mat.VecDense allocation that every KVector operation currently
+	pays for, which matters for constraint blocks built from very sparse
+	A x <= b data (e.g. MPC/QP problems where only a handful of entries per
+	row/column are nonzero).
+*/
+
+/*
+SparseKVector
+
+	A constant vector, like KVector, but stored as a COO list of
+	(index, value) pairs for its nonzero entries only.
+*/
+type SparseKVector struct {
+	Length  int
+	Indices []int
+	Values  []float64
+}
+
+/*
+NewSparseKVector
+Description:
+
+	Creates a SparseKVector of the given length from parallel indices/values
+	slices. The caller is responsible for ensuring indices are unique and
+	within [0, length).
+*/
+func NewSparseKVector(length int, indices []int, values []float64) SparseKVector {
+	return SparseKVector{Length: length, Indices: indices, Values: values}
+}
+
+/*
+Len
+Description:
+
+	Computes the (dense) length of the SparseKVector.
+*/
+func (skv SparseKVector) Len() int {
+	return skv.Length
+}
+
+/*
+NNZ
+Description:
+
+	Returns the number of explicitly-stored nonzero entries.
+*/
+func (skv SparseKVector) NNZ() int {
+	return len(skv.Values)
+}
+
+/*
+Dims
+Description:
+
+	Returns the dimensions ({length, 1}) of the SparseKVector, mirroring
+	KVector.Dims.
+*/
+func (skv SparseKVector) Dims() []int {
+	return []int{skv.Length, 1}
+}
+
+/*
+Check
+Description:
+
+	Verifies that the Indices and Values slices have matching length and that
+	every index is within bounds.
+*/
+func (skv SparseKVector) Check() error {
+	if len(skv.Indices) != len(skv.Values) {
+		return fmt.Errorf(
+			"SparseKVector has %v indices but %v values; these should match",
+			len(skv.Indices), len(skv.Values),
+		)
+	}
+
+	for _, idx := range skv.Indices {
+		if idx < 0 || idx >= skv.Length {
+			return fmt.Errorf(
+				"SparseKVector has an index %v out of bounds for a vector of length %v",
+				idx, skv.Length,
+			)
+		}
+	}
+
+	return nil
+}
+
+/*
+AtVec
+Description:
+
+	Returns the value at index idx, which is K(0) if idx is not explicitly
+	stored.
+*/
+func (skv SparseKVector) AtVec(idx int) ScalarExpression {
+	for ii, storedIdx := range skv.Indices {
+		if storedIdx == idx {
+			return K(skv.Values[ii])
+		}
+	}
+	return K(0)
+}
+
+/*
+Variables
+Description:
+
+	Returns the empty slice because no variables are in a constant vector.
+*/
+func (skv SparseKVector) Variables() []Variable {
+	return []Variable{}
+}
+
+/*
+LinearCoeff
+Description:
+
+	Returns the coefficients of the Variables in the expression. For a
+	constant vector, this is always the zero matrix.
+*/
+func (skv SparseKVector) LinearCoeff() mat.Dense {
+	return ZerosMatrix(skv.Len(), skv.Len())
+}
+
+/*
+Constant
+Description:
+
+	Returns the dense constant vector represented by skv.
+*/
+func (skv SparseKVector) Constant() mat.VecDense {
+	return skv.ToVecDense()
+}
+
+/*
+ToDense
+Description:
+
+	Converts the SparseKVector to a dense KVector.
+*/
+func (skv SparseKVector) ToDense() KVector {
+	out := make(KVector, skv.Length)
+	for ii, idx := range skv.Indices {
+		out[idx] = K(skv.Values[ii])
+	}
+	return out
+}
+
+/*
+ToVecDense
+Description:
+
+	Converts the SparseKVector to a dense mat.VecDense.
+*/
+func (skv SparseKVector) ToVecDense() mat.VecDense {
+	return skv.ToDense().ToVecDense()
+}
+
+/*
+SparseKVectorFromDense
+Description:
+
+	Builds a SparseKVector from a dense KVector, keeping only the nonzero
+	entries.
+*/
+func SparseKVectorFromDense(kv KVector) SparseKVector {
+	var indices []int
+	var values []float64
+	for ii, k := range kv {
+		if float64(k) != 0 {
+			indices = append(indices, ii)
+			values = append(values, float64(k))
+		}
+	}
+	return SparseKVector{Length: kv.Len(), Indices: indices, Values: values}
+}
+
+/*
+Plus
+Description:
+
+	Adds skv to another expression. Adding two SparseKVectors preserves
+	sparsity; mixing with a dense KVector falls back to dense addition.
+*/
+func (skv SparseKVector) Plus(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case SparseKVector:
+		if skv.Len() != right.Len() {
+			panic(fmt.Errorf(
+				"cannot add SparseKVector of length %v to one of length %v",
+				skv.Len(), right.Len(),
+			))
+		}
+
+		sums := make(map[int]float64)
+		for ii, idx := range skv.Indices {
+			sums[idx] += skv.Values[ii]
+		}
+		for ii, idx := range right.Indices {
+			sums[idx] += right.Values[ii]
+		}
+
+		var indices []int
+		var values []float64
+		for idx, val := range sums {
+			if val != 0 {
+				indices = append(indices, idx)
+				values = append(values, val)
+			}
+		}
+		return SparseKVector{Length: skv.Len(), Indices: indices, Values: values}
+
+	case KVector:
+		return skv.ToDense().Plus(right)
+
+	default:
+		return skv.ToDense().Plus(rightIn)
+	}
+}
+
+/*
+Multiply
+Description:
+
+	Multiplies skv by another expression. Scaling by a scalar preserves
+	sparsity; every other combination falls back to dense multiplication.
+*/
+func (skv SparseKVector) Multiply(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case float64:
+		values := make([]float64, len(skv.Values))
+		for ii, v := range skv.Values {
+			values[ii] = v * right
+		}
+		return SparseKVector{Length: skv.Length, Indices: append([]int{}, skv.Indices...), Values: values}
+
+	case K:
+		return skv.Multiply(float64(right))
+
+	default:
+		return skv.ToDense().Multiply(rightIn)
+	}
+}
+
+/*
+Transpose
+Description:
+
+	Returns the transpose of skv as a dense 1xN row vector, built from
+	skv.ToDense().Transpose(); the result is not a SparseKMatrix.
+*/
+func (skv SparseKVector) Transpose() Expression {
+	return skv.ToDense().Transpose()
+}
+
+/*
+Comparison
+Description:
+
+	Creates a VectorConstraint between skv (converted to dense form) and
+	rightIn. Use Indicate to tag the result as indicator-linked rather
+	than hard; see KVector.Comparison.
+*/
+func (skv SparseKVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
+	return skv.ToDense().Comparison(rightIn, sense)
+}
+
+/*
+LessEq
+Description:
+
+	Returns a less than or equal to (<=) constraint between skv and rightIn.
+*/
+func (skv SparseKVector) LessEq(rightIn interface{}) Constraint {
+	return skv.Comparison(rightIn, SenseLessThanEqual)
+}
+
+/*
+GreaterEq
+Description:
+
+	Returns a greater than or equal to (>=) constraint between skv and rightIn.
+*/
+func (skv SparseKVector) GreaterEq(rightIn interface{}) Constraint {
+	return skv.Comparison(rightIn, SenseGreaterThanEqual)
+}
+
+/*
+Eq
+Description:
+
+	Returns an equality (==) constraint between skv and rightIn.
+*/
+func (skv SparseKVector) Eq(rightIn interface{}) Constraint {
+	return skv.Comparison(rightIn, SenseEqual)
+}
+
+/*
+DerivativeWrt
+Description:
+
+	Computes the derivative of skv with respect to vIn, which is always the
+	zero vector since skv is constant.
+*/
+func (skv SparseKVector) DerivativeWrt(vIn Variable) Expression {
+	return SparseKVector{Length: skv.Length}
+}
+
+/*
+String
+Description:
+
+	Returns a string representation of the SparseKVector's dense form.
+*/
+func (skv SparseKVector) String() string {
+	return skv.ToDense().String()
+}
@@ -0,0 +1,336 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+variable_matrix.go
+Description:
+
+	Implements VariableMatrix, the matrix-valued analogue of VariableVector.
+	This is the "MatrixVar" object called out by the sibling MatProInterface
+	repo's TODO list: a first-class way to create a grid of decision variables
+	without resorting to a slice of VariableVector objects.
+*/
+
+/*
+VariableMatrix
+
+	Represents a matrix all of whose entries are (possibly repeated) Variable
+	objects.
+*/
+type VariableMatrix struct {
+	Elements [][]Variable
+}
+
+/*
+NewVariableMatrix
+Description:
+
+	Creates a new VariableMatrix of dimension rows x columns, where every
+	entry is a distinct, freshly created Variable.
+*/
+func NewVariableMatrix(rows, columns int) VariableMatrix {
+	elements := make([][]Variable, rows)
+	for ii := 0; ii < rows; ii++ {
+		elements[ii] = make([]Variable, columns)
+		for jj := 0; jj < columns; jj++ {
+			elements[ii][jj] = NewVariable()
+		}
+	}
+
+	return VariableMatrix{Elements: elements}
+}
+
+/*
+Dims
+Description:
+
+	Returns the dimensions ({rows, columns}) of the VariableMatrix.
+*/
+func (vm VariableMatrix) Dims() []int {
+	if len(vm.Elements) == 0 {
+		return []int{0, 0}
+	}
+	return []int{len(vm.Elements), len(vm.Elements[0])}
+}
+
+/*
+Check
+Description:
+
+	Verifies that every row of the VariableMatrix has the same number of
+	columns. The Variables themselves are not checked.
+*/
+func (vm VariableMatrix) Check() error {
+	if len(vm.Elements) == 0 {
+		return nil
+	}
+
+	nCols := len(vm.Elements[0])
+	for ii, row := range vm.Elements {
+		if len(row) != nCols {
+			return fmt.Errorf(
+				"row %v of the VariableMatrix has %v columns; expected %v (the length of row 0)",
+				ii, len(row), nCols,
+			)
+		}
+	}
+
+	return nil
+}
+
+/*
+Variables
+Description:
+
+	Returns the slice of unique Variable objects contained in the matrix.
+*/
+func (vm VariableMatrix) Variables() []Variable {
+	var out []Variable
+	seen := make(map[uint64]bool)
+	for _, row := range vm.Elements {
+		for _, v := range row {
+			if !seen[v.ID] {
+				seen[v.ID] = true
+				out = append(out, v)
+			}
+		}
+	}
+	return out
+}
+
+/*
+At
+Description:
+
+	Returns the Variable located at row i, column j as a ScalarExpression.
+*/
+func (vm VariableMatrix) At(i, j int) ScalarExpression {
+	return vm.Elements[i][j]
+}
+
+/*
+RowAt
+Description:
+
+	Returns the i-th row of the VariableMatrix as a VariableVector.
+*/
+func (vm VariableMatrix) RowAt(i int) VectorExpression {
+	row := make([]Variable, len(vm.Elements[i]))
+	copy(row, vm.Elements[i])
+	return VariableVector{Elements: row}
+}
+
+/*
+ColAt
+Description:
+
+	Returns the j-th column of the VariableMatrix as a VariableVector.
+*/
+func (vm VariableMatrix) ColAt(j int) VectorExpression {
+	col := make([]Variable, len(vm.Elements))
+	for ii := range vm.Elements {
+		col[ii] = vm.Elements[ii][j]
+	}
+	return VariableVector{Elements: col}
+}
+
+/*
+LinearCoeff
+Description:
+
+	Returns the coefficient of every Variable in vm.Variables() against
+	every entry of vm, flattened row-major: row (i*cols+j) of the result
+	holds a 1 in the column of the Variable located at vm.Elements[i][j]
+	and 0 elsewhere. This matches the (rows*cols) x nVars layout used by
+	PolynomialMatrix.LinearCoeff and SparseKMatrix.LinearCoeff.
+*/
+func (vm VariableMatrix) LinearCoeff() mat.Dense {
+	dims := vm.Dims()
+	vars := vm.Variables()
+	colOf := make(map[uint64]int, len(vars))
+	for kk, v := range vars {
+		colOf[v.ID] = kk
+	}
+
+	out := ZerosMatrix(dims[0]*dims[1], len(vars))
+	idx := 0
+	for ii := 0; ii < dims[0]; ii++ {
+		for jj := 0; jj < dims[1]; jj++ {
+			out.Set(idx, colOf[vm.Elements[ii][jj].ID], 1.0)
+			idx++
+		}
+	}
+	return out
+}
+
+/*
+Constant
+Description:
+
+	Returns the constant term of the VariableMatrix, which is always the zero
+	matrix since every entry is a bare Variable.
+*/
+func (vm VariableMatrix) Constant() mat.Dense {
+	dims := vm.Dims()
+	return ZerosMatrix(dims[0], dims[1])
+}
+
+/*
+Plus
+Description:
+
+	Adds the VariableMatrix to another matrix-valued object, entry by
+	entry. KMatrix and PolynomialMatrix operands are added directly;
+	MonomialMatrix is added via its ToPolynomialMatrix conversion, the
+	same pattern PolynomialMatrix.Plus uses.
+*/
+func (vm VariableMatrix) Plus(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case VariableMatrix:
+		dims1, dims2 := vm.Dims(), right.Dims()
+		if dims1[0] != dims2[0] || dims1[1] != dims2[1] {
+			panic(fmt.Errorf(
+				"cannot add VariableMatrix of dimension %v to one of dimension %v",
+				dims1, dims2,
+			))
+		}
+
+		elements := make([][]Polynomial, dims1[0])
+		for ii := range elements {
+			elements[ii] = make([]Polynomial, dims1[1])
+			for jj := range elements[ii] {
+				sum := vm.Elements[ii][jj].Plus(right.Elements[ii][jj])
+				elements[ii][jj] = sum.(ScalarExpression).ToPolynomial()
+			}
+		}
+		return PolynomialMatrix{Elements: elements}
+
+	case KMatrix:
+		dims1, dims2 := vm.Dims(), right.Dims()
+		if dims1[0] != dims2[0] || dims1[1] != dims2[1] {
+			panic(fmt.Errorf(
+				"cannot add VariableMatrix of dimension %v to KMatrix of dimension %v",
+				dims1, dims2,
+			))
+		}
+
+		elements := make([][]Polynomial, dims1[0])
+		for ii := range elements {
+			elements[ii] = make([]Polynomial, dims1[1])
+			for jj := range elements[ii] {
+				sum := vm.Elements[ii][jj].Plus(right.At(ii, jj))
+				elements[ii][jj] = sum.(ScalarExpression).ToPolynomial()
+			}
+		}
+		return PolynomialMatrix{Elements: elements}
+
+	case MonomialMatrix:
+		return vm.Plus(right.ToPolynomialMatrix())
+
+	case PolynomialMatrix:
+		dims1, dims2 := vm.Dims(), right.Dims()
+		if dims1[0] != dims2[0] || dims1[1] != dims2[1] {
+			panic(fmt.Errorf(
+				"cannot add VariableMatrix of dimension %v to PolynomialMatrix of dimension %v",
+				dims1, dims2,
+			))
+		}
+
+		elements := make([][]Polynomial, dims1[0])
+		for ii := range elements {
+			elements[ii] = make([]Polynomial, dims1[1])
+			for jj := range elements[ii] {
+				sum := vm.Elements[ii][jj].Plus(right.Elements[ii][jj])
+				elements[ii][jj] = sum.(ScalarExpression).ToPolynomial()
+			}
+		}
+		return PolynomialMatrix{Elements: elements}
+
+	default:
+		panic(fmt.Errorf(
+			"unrecognized right-hand side type %T for VariableMatrix.Plus(%v)!",
+			right, right,
+		))
+	}
+}
+
+/*
+Multiply
+Description:
+
+	Computes the matrix product of the VariableMatrix with a compatible
+	KVector, producing a PolynomialVector (each output row is, in general, a
+	linear combination of more than one of this matrix's variables, which a
+	Monomial cannot represent).
+*/
+func (vm VariableMatrix) Multiply(rightIn interface{}) Expression {
+	switch right := rightIn.(type) {
+	case KVector:
+		dims := vm.Dims()
+		if dims[1] != right.Len() {
+			panic(fmt.Errorf(
+				"cannot multiply VariableMatrix of dimension %v with KVector of length %v",
+				dims, right.Len(),
+			))
+		}
+
+		out := make(PolynomialVector, dims[0])
+		for ii := 0; ii < dims[0]; ii++ {
+			var sum Expression = K(0)
+			for jj := 0; jj < dims[1]; jj++ {
+				sum = sum.Plus(vm.Elements[ii][jj].Multiply(right[jj]))
+			}
+			out[ii] = sum.(ScalarExpression).ToPolynomial()
+		}
+		return out
+
+	default:
+		panic(fmt.Errorf(
+			"unrecognized right-hand side type %T for VariableMatrix.Multiply(%v)!",
+			right, right,
+		))
+	}
+}
+
+/*
+Transpose
+Description:
+
+	Returns the transpose of the VariableMatrix.
+*/
+func (vm VariableMatrix) Transpose() Expression {
+	dims := vm.Dims()
+	out := make([][]Variable, dims[1])
+	for jj := 0; jj < dims[1]; jj++ {
+		out[jj] = make([]Variable, dims[0])
+		for ii := 0; ii < dims[0]; ii++ {
+			out[jj][ii] = vm.Elements[ii][jj]
+		}
+	}
+	return VariableMatrix{Elements: out}
+}
+
+/*
+DerivativeWrt
+Description:
+
+	Computes the entry-wise derivative of the VariableMatrix with respect to
+	vIn, returning a 0/1-valued KMatrix.
+*/
+func (vm VariableMatrix) DerivativeWrt(vIn Variable) Expression {
+	dims := vm.Dims()
+	out := ZerosMatrix(dims[0], dims[1])
+	for ii := 0; ii < dims[0]; ii++ {
+		for jj := 0; jj < dims[1]; jj++ {
+			if vm.Elements[ii][jj].ID == vIn.ID {
+				out.Set(ii, jj, 1.0)
+			}
+		}
+	}
+	return DenseToKMatrix(out)
+}
@@ -0,0 +1,84 @@
+package symbolic_test
+
+/*
+constructors_test.go
+Description:
+	Tests for the functions mentioned in the constructors.go file.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+	"testing"
+)
+
+/*
+TestNewKVector1
+Description:
+
+	Verifies that NewKVector builds a KVector with the given data.
+*/
+func TestNewKVector1(t *testing.T) {
+	// Constants
+	data := []float64{1.0, 2.0, 3.0}
+	kv := symbolic.NewKVector(3, data)
+
+	// Test
+	for ii, d := range data {
+		if v := float64(kv[ii]); v != d {
+			t.Errorf("Expected kv[%v] to be %v; received %v", ii, d, v)
+		}
+	}
+}
+
+/*
+TestNewKMatrix1
+Description:
+
+	Verifies that NewKMatrix builds a KMatrix of the expected dimensions from
+	row-major data.
+*/
+func TestNewKMatrix1(t *testing.T) {
+	// Constants
+	km := symbolic.NewKMatrix(2, 3, []float64{1, 2, 3, 4, 5, 6})
+
+	// Test
+	dims := km.Dims()
+	if dims[0] != 2 || dims[1] != 3 {
+		t.Errorf("Expected km.Dims() to be [2 3]; received %v", dims)
+	}
+	if v := km.At(1, 2).Constant(); v != 6 {
+		t.Errorf("Expected km.At(1,2).Constant() to be 6; received %v", v)
+	}
+}
+
+/*
+TestKVector_MulVec1
+Description:
+
+	Verifies that MulVec computes A*x correctly, and that it returns a
+	PolynomialVector (not a MonomialVector) when a row of A has more than
+	one nonzero entry.
+*/
+func TestKVector_MulVec1(t *testing.T) {
+	// Constants
+	var kv symbolic.KVector
+	A := mat.NewDense(2, 2, []float64{1, 1, 0, 2})
+	x := symbolic.NewKVector(2, []float64{3.0, 4.0})
+
+	// Test
+	result := kv.MulVec(A, x)
+	pv, ok := result.(symbolic.PolynomialVector)
+	if !ok {
+		t.Fatalf(
+			"Expected kv.MulVec(A, x) to be a PolynomialVector; received %T",
+			result,
+		)
+	}
+	if v := pv[0].Constant(); v != 7.0 {
+		t.Errorf("Expected pv[0].Constant() to be 7.0 (1*3 + 1*4); received %v", v)
+	}
+	if v := pv[1].Constant(); v != 8.0 {
+		t.Errorf("Expected pv[1].Constant() to be 8.0 (0*3 + 2*4); received %v", v)
+	}
+}
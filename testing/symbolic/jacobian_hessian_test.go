@@ -0,0 +1,197 @@
+package symbolic_test
+
+/*
+jacobian_hessian_test.go
+Description:
+	Tests for the functions mentioned in the jacobian_hessian.go file.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"testing"
+)
+
+/*
+TestKVector_Jacobian1
+Description:
+
+	Verifies that the Jacobian of a constant KVector is the zero matrix of
+	the expected dimensions.
+*/
+func TestKVector_Jacobian1(t *testing.T) {
+	// Constants
+	kv := symbolic.NewKVector(3, []float64{1.0, 2.0, 3.0})
+	vars := []symbolic.Variable{symbolic.NewVariable(), symbolic.NewVariable()}
+
+	// Test
+	jac := kv.Jacobian(vars)
+	dims := jac.Dims()
+	if dims[0] != 3 || dims[1] != 2 {
+		t.Errorf(
+			"Expected kv.Jacobian(vars).Dims() to be [3 2]; received %v",
+			dims,
+		)
+	}
+
+	for ii := 0; ii < dims[0]; ii++ {
+		for jj := 0; jj < dims[1]; jj++ {
+			if c := jac.At(ii, jj).Constant(); c != 0 {
+				t.Errorf(
+					"Expected jac.At(%v,%v).Constant() to be 0; received %v",
+					ii, jj, c,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestVariableVector_Jacobian1
+Description:
+
+	Verifies that the Jacobian of a VariableVector with respect to its own
+	elements is the identity matrix.
+*/
+func TestVariableVector_Jacobian1(t *testing.T) {
+	// Constants
+	N := 4
+	vv := symbolic.NewVariableVector(N)
+
+	// Test
+	jac := vv.Jacobian(vv.Elements)
+	for ii := 0; ii < N; ii++ {
+		for jj := 0; jj < N; jj++ {
+			expected := 0.0
+			if ii == jj {
+				expected = 1.0
+			}
+			if c := jac.At(ii, jj).Constant(); c != expected {
+				t.Errorf(
+					"Expected jac.At(%v,%v).Constant() to be %v; received %v",
+					ii, jj, expected, c,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestPolynomialVector_Hessian1
+Description:
+
+	Verifies that PolynomialVector.Hessian returns one MatrixExpression per
+	entry of the receiver.
+*/
+func TestPolynomialVector_Hessian1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{x.ToPolynomial(), x.ToPolynomial()}
+	vars := []symbolic.Variable{x}
+
+	// Test
+	hessians := pv.Hessian(vars)
+	if len(hessians) != pv.Len() {
+		t.Errorf(
+			"Expected len(pv.Hessian(vars)) to be %v; received %v",
+			pv.Len(),
+			len(hessians),
+		)
+	}
+
+	for _, h := range hessians {
+		dims := h.Dims()
+		if dims[0] != 1 || dims[1] != 1 {
+			t.Errorf(
+				"Expected each Hessian to have dims [1 1]; received %v",
+				dims,
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialVector_Hessian_Quadratic1
+Description:
+
+	Verifies that PolynomialVector.Hessian returns the correct nonzero
+	numeric value for a genuinely quadratic entry (x^2, whose second
+	derivative is 2). TestPolynomialVector_Hessian1 only exercises a
+	linear entry, which would pass even if Hessian always returned zero.
+*/
+func TestPolynomialVector_Hessian_Quadratic1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	xSquared := x.Multiply(x).(symbolic.ScalarExpression).ToPolynomial()
+	pv := symbolic.PolynomialVector{xSquared}
+	vars := []symbolic.Variable{x}
+
+	// Test
+	hessians := pv.Hessian(vars)
+	if len(hessians) != 1 {
+		t.Fatalf("Expected 1 Hessian; received %v", len(hessians))
+	}
+	if v := hessians[0].At(0, 0).Constant(); v != 2.0 {
+		t.Errorf(
+			"Expected d^2(x^2)/dx^2 to be 2.0; received %v",
+			v,
+		)
+	}
+}
+
+/*
+TestMonomialVector_Jacobian1
+Description:
+
+	Verifies that MonomialVector.Jacobian differentiates a quadratic
+	Monomial (x^2) down to a non-constant linear Polynomial (2x), rather
+	than trivially zero.
+*/
+func TestMonomialVector_Jacobian1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	xSquared := x.Multiply(x).(symbolic.Monomial)
+	mv := symbolic.MonomialVector{xSquared}
+	vars := []symbolic.Variable{x}
+
+	// Test
+	jac := mv.Jacobian(vars)
+	dims := jac.Dims()
+	if dims[0] != 1 || dims[1] != 1 {
+		t.Errorf("Expected mv.Jacobian(vars).Dims() to be [1 1]; received %v", dims)
+	}
+	if len(jac.At(0, 0).Variables()) != 1 {
+		t.Errorf(
+			"Expected d(x^2)/dx (2x) to still depend on x; received %v",
+			jac.At(0, 0),
+		)
+	}
+}
+
+/*
+TestMonomialVector_Hessian1
+Description:
+
+	Verifies that MonomialVector.Hessian returns the correct nonzero
+	numeric value for a quadratic Monomial (x^2), exercising the same
+	MonomialVector.ToPolynomialVector().Hessian() delegation path with a
+	non-trivial second derivative.
+*/
+func TestMonomialVector_Hessian1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	xSquared := x.Multiply(x).(symbolic.Monomial)
+	mv := symbolic.MonomialVector{xSquared}
+	vars := []symbolic.Variable{x}
+
+	// Test
+	hessians := mv.Hessian(vars)
+	if len(hessians) != 1 {
+		t.Fatalf("Expected 1 Hessian; received %v", len(hessians))
+	}
+	if v := hessians[0].At(0, 0).Constant(); v != 2.0 {
+		t.Errorf(
+			"Expected d^2(x^2)/dx^2 to be 2.0; received %v",
+			v,
+		)
+	}
+}
@@ -0,0 +1,364 @@
+package symbolic_test
+
+/*
+matrix_expression_test.go
+Description:
+	Tests for the functions mentioned in the matrix_expression.go,
+	variable_matrix.go, monomial_matrix.go, and polynomial_matrix.go files.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"testing"
+)
+
+/*
+TestVariableMatrix_Dims1
+Description:
+
+	Verifies that a 3x4 VariableMatrix reports the correct dimensions.
+*/
+func TestVariableMatrix_Dims1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(3, 4)
+
+	// Test
+	dims := vm.Dims()
+	if dims[0] != 3 || dims[1] != 4 {
+		t.Errorf(
+			"Expected vm.Dims() to be [3 4]; received %v",
+			dims,
+		)
+	}
+}
+
+/*
+TestVariableMatrix_Variables1
+Description:
+
+	Verifies that every entry of a VariableMatrix is a distinct Variable.
+*/
+func TestVariableMatrix_Variables1(t *testing.T) {
+	// Constants
+	rows, cols := 3, 4
+	vm := symbolic.NewVariableMatrix(rows, cols)
+
+	// Test
+	vars := vm.Variables()
+	if len(vars) != rows*cols {
+		t.Errorf(
+			"Expected len(vm.Variables()) to be %v; received %v",
+			rows*cols,
+			len(vars),
+		)
+	}
+}
+
+/*
+TestVariableMatrix_Multiply1
+Description:
+
+	Verifies that multiplying a 2x2 VariableMatrix (whose rows each have two
+	nonzero entries) by a KVector produces a PolynomialVector, since each
+	output row is a sum of two distinct variable terms and cannot be
+	represented by a single Monomial.
+*/
+func TestVariableMatrix_Multiply1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(2, 2)
+	x := symbolic.NewKVector(2, []float64{1.0, 2.0})
+
+	// Test
+	result := vm.Multiply(x)
+	pv, ok := result.(symbolic.PolynomialVector)
+	if !ok {
+		t.Fatalf(
+			"Expected vm.Multiply(x) to be a PolynomialVector; received %T",
+			result,
+		)
+	}
+	if pv.Len() != 2 {
+		t.Errorf(
+			"Expected len(pv) to be 2; received %v",
+			pv.Len(),
+		)
+	}
+}
+
+/*
+TestVariableMatrix_Plus1
+Description:
+
+	Verifies that VariableMatrix.Plus accepts a KMatrix operand, producing
+	a PolynomialMatrix of the same dimensions. Previously Plus only
+	accepted another VariableMatrix and panicked on everything else.
+*/
+func TestVariableMatrix_Plus1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(1, 2)
+	km := symbolic.NewKMatrix(1, 2, []float64{3.0, 4.0})
+
+	// Test
+	result := vm.Plus(km)
+	pm, ok := result.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Fatalf(
+			"Expected vm.Plus(km) to be a PolynomialMatrix; received %T",
+			result,
+		)
+	}
+	if dims := pm.Dims(); dims[0] != 1 || dims[1] != 2 {
+		t.Errorf("Expected pm.Dims() to be [1 2]; received %v", dims)
+	}
+}
+
+/*
+TestVariableMatrix_Plus_PolynomialMatrix1
+Description:
+
+	Verifies that VariableMatrix.Plus accepts a PolynomialMatrix operand.
+*/
+func TestVariableMatrix_Plus_PolynomialMatrix1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(1, 1)
+	x := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		Elements: [][]symbolic.Polynomial{
+			{x.ToPolynomial()},
+		},
+	}
+
+	// Test
+	result := vm.Plus(pm)
+	out, ok := result.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Fatalf(
+			"Expected vm.Plus(pm) to be a PolynomialMatrix; received %T",
+			result,
+		)
+	}
+	if dims := out.Dims(); dims[0] != 1 || dims[1] != 1 {
+		t.Errorf("Expected out.Dims() to be [1 1]; received %v", dims)
+	}
+}
+
+/*
+TestVariableMatrix_LinearCoeff1
+Description:
+
+	Verifies that LinearCoeff for a rectangular (non-square) VariableMatrix
+	is flattened row-major into a (rows*cols) x nVars matrix, matching the
+	convention used by PolynomialMatrix.LinearCoeff and
+	SparseKMatrix.LinearCoeff, rather than the nVars x nVars identity a
+	square-only implementation would produce.
+*/
+func TestVariableMatrix_LinearCoeff1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(2, 3)
+
+	// Test
+	L := vm.LinearCoeff()
+	rows, cols := L.Dims()
+	if rows != 6 {
+		t.Errorf("Expected vm.LinearCoeff() to have 6 rows (2*3); received %v", rows)
+	}
+	if cols != 6 {
+		t.Errorf("Expected vm.LinearCoeff() to have 6 columns (6 distinct variables); received %v", cols)
+	}
+
+	nnz := 0
+	for ii := 0; ii < rows; ii++ {
+		for jj := 0; jj < cols; jj++ {
+			if v := L.At(ii, jj); v != 0 {
+				nnz++
+				if v != 1 {
+					t.Errorf("Expected a nonzero entry of vm.LinearCoeff() to be 1; received %v", v)
+				}
+			}
+		}
+	}
+	if nnz != rows {
+		t.Errorf("Expected exactly one nonzero entry per row (%v total); received %v", rows, nnz)
+	}
+}
+
+/*
+TestMonomialMatrix_Dims1
+Description:
+
+	Verifies that a MonomialMatrix built from a 2x3 grid of Monomials
+	reports the correct dimensions.
+*/
+func TestMonomialMatrix_Dims1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	mm := symbolic.MonomialMatrix{
+		Elements: [][]symbolic.Monomial{
+			{x.ToMonomial(), x.ToMonomial(), x.ToMonomial()},
+			{x.ToMonomial(), x.ToMonomial(), x.ToMonomial()},
+		},
+	}
+
+	// Test
+	dims := mm.Dims()
+	if dims[0] != 2 || dims[1] != 3 {
+		t.Errorf(
+			"Expected mm.Dims() to be [2 3]; received %v",
+			dims,
+		)
+	}
+}
+
+/*
+TestMonomialMatrix_Plus1
+Description:
+
+	Verifies that MonomialMatrix.Plus produces a PolynomialMatrix of the
+	same dimensions as its receiver (since MonomialMatrix delegates to
+	PolynomialMatrix for every MatrixExpression method besides the purely
+	structural ones).
+*/
+func TestMonomialMatrix_Plus1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	mm := symbolic.MonomialMatrix{
+		Elements: [][]symbolic.Monomial{
+			{x.ToMonomial()},
+		},
+	}
+
+	// Test
+	result := mm.Plus(mm)
+	pm, ok := result.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Fatalf(
+			"Expected mm.Plus(mm) to be a PolynomialMatrix; received %T",
+			result,
+		)
+	}
+	if dims := pm.Dims(); dims[0] != 1 || dims[1] != 1 {
+		t.Errorf(
+			"Expected pm.Dims() to be [1 1]; received %v",
+			dims,
+		)
+	}
+}
+
+/*
+TestKVector_Multiply_OuterProduct1
+Description:
+
+	Verifies that KVector.Multiply(KMatrix) (a row matrix) computes the
+	outer product directly as a KMatrix, not a PolynomialMatrix, since
+	neither operand has any Variables.
+*/
+func TestKVector_Multiply_OuterProduct1(t *testing.T) {
+	// Constants
+	kv := symbolic.NewKVector(2, []float64{1.0, 2.0})
+	row := symbolic.NewKMatrix(1, 3, []float64{3.0, 4.0, 5.0})
+
+	// Test
+	result := kv.Multiply(row)
+	km, ok := result.(symbolic.KMatrix)
+	if !ok {
+		t.Fatalf(
+			"Expected kv.Multiply(row) to be a KMatrix when both operands are constant; received %T",
+			result,
+		)
+	}
+	dims := km.Dims()
+	if dims[0] != 2 || dims[1] != 3 {
+		t.Errorf("Expected km.Dims() to be [2 3]; received %v", dims)
+	}
+	if v := km.At(1, 2).Constant(); v != 10.0 {
+		t.Errorf("Expected km.At(1,2).Constant() to be 10.0 (2*5); received %v", v)
+	}
+}
+
+/*
+TestKVector_Multiply_Transpose1
+Description:
+
+	Verifies that transposing a KVector and multiplying it by another
+	KVector (routing through KMatrix.Multiply, the reverse call direction
+	from TestKVector_Multiply_OuterProduct1) produces a 1x1 KMatrix
+	holding their dot product.
+*/
+func TestKVector_Multiply_Transpose1(t *testing.T) {
+	// Constants
+	kv1 := symbolic.NewKVector(3, []float64{1.0, 2.0, 3.0})
+	kv2 := symbolic.NewKVector(3, []float64{4.0, 5.0, 6.0})
+
+	// Test
+	result := kv1.Transpose().Multiply(kv2)
+	km, ok := result.(symbolic.KMatrix)
+	if !ok {
+		t.Fatalf(
+			"Expected kv1.Transpose().Multiply(kv2) to be a KMatrix; received %T",
+			result,
+		)
+	}
+	if v := km.At(0, 0).Constant(); v != 32.0 {
+		t.Errorf("Expected the dot product 1*4+2*5+3*6=32; received %v", v)
+	}
+}
+
+/*
+TestPolynomialMatrix_Constant1
+Description:
+
+	Verifies that PolynomialMatrix.Constant returns a matrix of the same
+	dimensions as the receiver.
+*/
+func TestPolynomialMatrix_Constant1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		Elements: [][]symbolic.Polynomial{
+			{x.ToPolynomial(), x.ToPolynomial()},
+		},
+	}
+
+	// Test
+	c := pm.Constant()
+	rows, cols := c.Dims()
+	if rows != 1 || cols != 2 {
+		t.Errorf(
+			"Expected pm.Constant() to have dims (1,2); received (%v,%v)",
+			rows, cols,
+		)
+	}
+}
+
+/*
+TestPolynomialMatrix_DerivativeWrt1
+Description:
+
+	Verifies that PolynomialMatrix.DerivativeWrt returns a PolynomialMatrix
+	of the same dimensions as the receiver.
+*/
+func TestPolynomialMatrix_DerivativeWrt1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	pm := symbolic.PolynomialMatrix{
+		Elements: [][]symbolic.Polynomial{
+			{x.ToPolynomial()},
+		},
+	}
+
+	// Test
+	result := pm.DerivativeWrt(x)
+	deriv, ok := result.(symbolic.PolynomialMatrix)
+	if !ok {
+		t.Fatalf(
+			"Expected pm.DerivativeWrt(x) to be a PolynomialMatrix; received %T",
+			result,
+		)
+	}
+	if dims := deriv.Dims(); dims[0] != 1 || dims[1] != 1 {
+		t.Errorf(
+			"Expected deriv.Dims() to be [1 1]; received %v",
+			dims,
+		)
+	}
+}
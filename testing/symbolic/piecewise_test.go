@@ -0,0 +1,142 @@
+package symbolic_test
+
+/*
+piecewise_test.go
+Description:
+	Tests for the functions mentioned in the piecewise.go file.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"testing"
+)
+
+/*
+TestKVector_Step1
+Description:
+
+	Verifies that Step evaluates to 1 for nonnegative entries and 0 for
+	negative ones.
+*/
+func TestKVector_Step1(t *testing.T) {
+	// Constants
+	kv := symbolic.NewKVector(3, []float64{-1.0, 0.0, 2.0})
+
+	// Test
+	stepped := kv.Step()
+	expected := []float64{0.0, 1.0, 1.0}
+	for ii, e := range expected {
+		if v := float64(stepped[ii]); v != e {
+			t.Errorf(
+				"Expected stepped[%v] to be %v; received %v",
+				ii, e, v,
+			)
+		}
+	}
+}
+
+/*
+TestCond_Constant1
+Description:
+
+	Verifies that Cond resolves immediately for a constant predicate.
+*/
+func TestCond_Constant1(t *testing.T) {
+	// Constants
+	a, b := symbolic.K(1.0), symbolic.K(2.0)
+
+	// Test
+	if result := symbolic.Cond(symbolic.K(1.0), a, b); result.(symbolic.K) != a {
+		t.Errorf("Expected Cond(K(1), a, b) to be a; received %v", result)
+	}
+	if result := symbolic.Cond(symbolic.K(0.0), a, b); result.(symbolic.K) != b {
+		t.Errorf("Expected Cond(K(0), a, b) to be b; received %v", result)
+	}
+}
+
+/*
+TestCond_Variable1
+Description:
+
+	Verifies that Cond with an indicator Variable predicate does not resolve
+	immediately but builds the ScalarExpression z*a + (1-z)*b.
+*/
+func TestCond_Variable1(t *testing.T) {
+	// Constants
+	z := symbolic.NewVariable()
+	a, b := symbolic.K(10.0), symbolic.K(20.0)
+
+	// Test
+	result := symbolic.Cond(z, a, b)
+	if _, ok := result.(symbolic.ScalarExpression); !ok {
+		t.Errorf(
+			"Expected Cond(z, a, b) to be a ScalarExpression; received %T",
+			result,
+		)
+	}
+}
+
+/*
+TestIndicator1
+Description:
+
+	Verifies that wrapping a KVector comparison with Indicate produces an
+	IndicatorConstraint, and that Indicator() recovers the binary Variable
+	linked to it.
+*/
+func TestIndicator1(t *testing.T) {
+	// Constants
+	lhs := symbolic.NewKVector(1, []float64{1.0})
+	rhs := symbolic.NewKVector(1, []float64{2.0})
+	z := symbolic.NewVariable()
+
+	// Test
+	constr := symbolic.Indicate(lhs.LessEq(rhs), z)
+	if _, ok := constr.(symbolic.IndicatorConstraint); !ok {
+		t.Fatalf(
+			"Expected Indicate(lhs.LessEq(rhs), z) to be an IndicatorConstraint; received %T",
+			constr,
+		)
+	}
+
+	indicatorExpr := symbolic.Indicator(constr)
+	if _, ok := indicatorExpr.(symbolic.ScalarExpression); !ok {
+		t.Errorf(
+			"Expected Indicator(constr) to be a ScalarExpression; received %T",
+			indicatorExpr,
+		)
+	}
+}
+
+/*
+TestLinearizeIndicators1
+Description:
+
+	Verifies that LinearizeIndicators passes hard constraints through
+	unchanged and rewrites an indicator-linked constraint into its
+	<= big-M form.
+*/
+func TestLinearizeIndicators1(t *testing.T) {
+	// Constants
+	lhs := symbolic.NewKVector(1, []float64{1.0})
+	rhs := symbolic.NewKVector(1, []float64{2.0})
+	z := symbolic.NewVariable()
+
+	hard := lhs.LessEq(rhs)
+	linked := symbolic.Indicate(lhs.LessEq(rhs), z)
+
+	// Test
+	out := symbolic.LinearizeIndicators([]symbolic.Constraint{hard, linked}, 100.0)
+	if len(out) != 2 {
+		t.Fatalf(
+			"Expected LinearizeIndicators to return 2 constraints; received %v",
+			len(out),
+		)
+	}
+	if _, ok := out[0].(symbolic.IndicatorConstraint); ok {
+		t.Errorf("Expected the hard constraint to pass through unchanged, not remain indicator-linked")
+	}
+	if _, ok := out[1].(symbolic.IndicatorConstraint); ok {
+		t.Errorf("Expected the indicator-linked constraint to be rewritten into a plain Constraint")
+	}
+}
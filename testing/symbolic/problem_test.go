@@ -0,0 +1,200 @@
+package symbolic_test
+
+/*
+problem_test.go
+Description:
+	Tests for the functions mentioned in the problem.go file.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"testing"
+)
+
+/*
+TestProblem_ToStandardForm1
+Description:
+
+	Verifies that a simple LP (linear objective, one linear VectorConstraint
+	of length 2) is classified as an LP and produces one inequality row per
+	entry of the constraint (2 rows).
+*/
+func TestProblem_ToStandardForm1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+	p := symbolic.NewProblem()
+	p.Minimize(x.Elements[0].Plus(x.Elements[1]).(symbolic.ScalarExpression))
+	p.AddConstraint(
+		symbolic.VariableVector{Elements: x.Elements}.Comparison(
+			symbolic.NewKVector(2, []float64{1.0, 1.0}),
+			symbolic.SenseLessThanEqual,
+		),
+	)
+
+	// Test
+	sf := p.ToStandardForm()
+	if sf.Class != symbolic.ProblemClassLP {
+		t.Errorf(
+			"Expected sf.Class to be ProblemClassLP; received %v",
+			sf.Class,
+		)
+	}
+
+	rows, _ := sf.AIneq.Dims()
+	if rows != 2 {
+		t.Errorf(
+			"Expected sf.AIneq to have 2 rows; received %v",
+			rows,
+		)
+	}
+}
+
+/*
+TestProblem_ToStandardForm_ScalarConstraint1
+Description:
+
+	Verifies that a linear ScalarConstraint contributes a row to the
+	standard-form inequality system and does not get misclassified as a
+	quadratic constraint.
+*/
+func TestProblem_ToStandardForm_ScalarConstraint1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := symbolic.NewProblem()
+	p.Minimize(x.ToPolynomial())
+	p.AddConstraint(x.LessEq(symbolic.K(1.0)))
+
+	// Test
+	sf := p.ToStandardForm()
+	if sf.Class != symbolic.ProblemClassLP {
+		t.Errorf(
+			"Expected sf.Class to be ProblemClassLP; received %v",
+			sf.Class,
+		)
+	}
+
+	rows, _ := sf.AIneq.Dims()
+	if rows != 1 {
+		t.Errorf(
+			"Expected sf.AIneq to have 1 row for the ScalarConstraint; received %v",
+			rows,
+		)
+	}
+
+	if len(sf.Vars) != 1 {
+		t.Errorf(
+			"Expected sf.Vars to contain the 1 variable used in the constraint; received %v",
+			len(sf.Vars),
+		)
+	}
+}
+
+/*
+TestQuadraticCoeff1
+Description:
+
+	Verifies that QuadraticCoeff returns the zero matrix for a purely linear
+	expression.
+*/
+func TestQuadraticCoeff1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vars := []symbolic.Variable{x}
+
+	// Test
+	Q := symbolic.QuadraticCoeff(x.ToPolynomial(), vars)
+	if v := Q.At(0, 0); v != 0 {
+		t.Errorf(
+			"Expected QuadraticCoeff(x, vars).At(0,0) to be 0 for a linear expression; received %v",
+			v,
+		)
+	}
+}
+
+/*
+TestQuadraticCoeff_Quadratic1
+Description:
+
+	Verifies that QuadraticCoeff returns the correct nonzero numeric value
+	for a genuinely quadratic expression (x^2, whose Hessian is the
+	constant 2, so Q = Hessian/2 = 1).
+*/
+func TestQuadraticCoeff_Quadratic1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	vars := []symbolic.Variable{x}
+	xSquared := x.Multiply(x).(symbolic.ScalarExpression)
+
+	// Test
+	Q := symbolic.QuadraticCoeff(xSquared, vars)
+	if v := Q.At(0, 0); v != 1.0 {
+		t.Errorf(
+			"Expected QuadraticCoeff(x^2, vars).At(0,0) to be 1.0; received %v",
+			v,
+		)
+	}
+}
+
+/*
+TestProblem_ToStandardForm_QP1
+Description:
+
+	Verifies that a quadratic objective (minimize x^2) with a linear
+	constraint is classified as a QP, and that the returned Q matrix holds
+	the correct numeric coefficient.
+*/
+func TestProblem_ToStandardForm_QP1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := symbolic.NewProblem()
+	p.Minimize(x.Multiply(x).(symbolic.ScalarExpression))
+	p.AddConstraint(x.LessEq(symbolic.K(1.0)))
+
+	// Test
+	sf := p.ToStandardForm()
+	if sf.Class != symbolic.ProblemClassQP {
+		t.Errorf(
+			"Expected sf.Class to be ProblemClassQP; received %v",
+			sf.Class,
+		)
+	}
+	if len(sf.Q) != 1 {
+		t.Fatalf("Expected sf.Q to contain 1 matrix; received %v", len(sf.Q))
+	}
+	if v := sf.Q[0].At(0, 0); v != 1.0 {
+		t.Errorf("Expected sf.Q[0].At(0,0) to be 1.0; received %v", v)
+	}
+}
+
+/*
+TestProblem_ToStandardForm_QCQP1
+Description:
+
+	Verifies that a quadratic objective combined with a quadratic
+	constraint is classified as a QCQP, and that the quadratic constraint
+	does not erroneously contribute a linear row to AIneq.
+*/
+func TestProblem_ToStandardForm_QCQP1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := symbolic.NewProblem()
+	p.Minimize(x.Multiply(x).(symbolic.ScalarExpression))
+	p.AddConstraint(x.Multiply(x).(symbolic.ScalarExpression).LessEq(symbolic.K(1.0)))
+
+	// Test
+	sf := p.ToStandardForm()
+	if sf.Class != symbolic.ProblemClassQCQP {
+		t.Errorf(
+			"Expected sf.Class to be ProblemClassQCQP; received %v",
+			sf.Class,
+		)
+	}
+
+	rows, _ := sf.AIneq.Dims()
+	if rows != 0 {
+		t.Errorf(
+			"Expected sf.AIneq to have 0 rows (the quadratic constraint isn't linearized); received %v",
+			rows,
+		)
+	}
+}
@@ -0,0 +1,155 @@
+package symbolic_test
+
+/*
+sparse_kvector_test.go
+Description:
+	Tests for the functions mentioned in the sparse_kvector.go and
+	sparse_kmatrix.go files.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"testing"
+)
+
+/*
+TestSparseKVector_Dims1
+Description:
+
+	Verifies that a length-5 SparseKVector reports dimensions [5 1], matching
+	KVector.Dims.
+*/
+func TestSparseKVector_Dims1(t *testing.T) {
+	// Constants
+	skv := symbolic.NewSparseKVector(5, []int{1, 3}, []float64{2.0, 4.0})
+
+	// Test
+	dims := skv.Dims()
+	if dims[0] != 5 || dims[1] != 1 {
+		t.Errorf(
+			"Expected skv.Dims() to be [5 1]; received %v",
+			dims,
+		)
+	}
+}
+
+/*
+TestSparseKVector_LinearCoeff1
+Description:
+
+	Verifies that a SparseKVector's LinearCoeff is the zero matrix, mirroring
+	KVector's behavior for a constant vector.
+*/
+func TestSparseKVector_LinearCoeff1(t *testing.T) {
+	// Constants
+	skv := symbolic.NewSparseKVector(3, []int{0}, []float64{1.0})
+
+	// Test
+	L := skv.LinearCoeff()
+	rows, cols := L.Dims()
+	for ii := 0; ii < rows; ii++ {
+		for jj := 0; jj < cols; jj++ {
+			if v := L.At(ii, jj); v != 0 {
+				t.Errorf(
+					"Expected skv.LinearCoeff().At(%v,%v) to be 0; received %v",
+					ii, jj, v,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestSparseKVector_ToDense1
+Description:
+
+	Verifies that converting a SparseKVector to dense form preserves its
+	explicitly-stored values and fills the rest with zero.
+*/
+func TestSparseKVector_ToDense1(t *testing.T) {
+	// Constants
+	skv := symbolic.NewSparseKVector(3, []int{1}, []float64{7.0})
+
+	// Test
+	dense := skv.ToDense()
+	if dense.Len() != 3 {
+		t.Errorf("Expected dense.Len() to be 3; received %v", dense.Len())
+	}
+	if v := dense.AtVec(1).Constant(); v != 7.0 {
+		t.Errorf("Expected dense.AtVec(1).Constant() to be 7.0; received %v", v)
+	}
+	if v := dense.AtVec(0).Constant(); v != 0.0 {
+		t.Errorf("Expected dense.AtVec(0).Constant() to be 0.0; received %v", v)
+	}
+}
+
+/*
+TestSparseKMatrix_LinearCoeff1
+Description:
+
+	Verifies that a SparseKMatrix's LinearCoeff is the zero matrix, mirroring
+	the constant-matrix convention used elsewhere in the package.
+*/
+func TestSparseKMatrix_LinearCoeff1(t *testing.T) {
+	// Constants
+	skm := symbolic.NewSparseKMatrixFromTriplets(2, 2, []int{0}, []int{1}, []float64{3.0})
+
+	// Test
+	L := skm.LinearCoeff()
+	rows, cols := L.Dims()
+	for ii := 0; ii < rows; ii++ {
+		for jj := 0; jj < cols; jj++ {
+			if v := L.At(ii, jj); v != 0 {
+				t.Errorf(
+					"Expected skm.LinearCoeff().At(%v,%v) to be 0; received %v",
+					ii, jj, v,
+				)
+			}
+		}
+	}
+}
+
+/*
+TestSparseKMatrix_LinearCoeff_Rectangular1
+Description:
+
+	Verifies that LinearCoeff respects both Rows and Cols for a non-square
+	SparseKMatrix; a 2x3 matrix's square-only former implementation
+	(ZerosMatrix(Rows, Rows)) would have produced a 2x2 result here instead
+	of the flattened (Rows*Cols) x 0 shape.
+*/
+func TestSparseKMatrix_LinearCoeff_Rectangular1(t *testing.T) {
+	// Constants
+	skm := symbolic.NewSparseKMatrixFromTriplets(2, 3, []int{0, 1}, []int{2, 0}, []float64{5.0, 6.0})
+
+	// Test
+	L := skm.LinearCoeff()
+	rows, cols := L.Dims()
+	if rows != 6 {
+		t.Errorf("Expected skm.LinearCoeff() to have 6 rows (2*3); received %v", rows)
+	}
+	if cols != 0 {
+		t.Errorf("Expected skm.LinearCoeff() to have 0 columns (no Variables); received %v", cols)
+	}
+}
+
+/*
+TestSparseKMatrix_RowAt1
+Description:
+
+	Verifies that SparseKMatrix.RowAt returns a SparseKVector reflecting only
+	the requested row's explicitly-stored entries.
+*/
+func TestSparseKMatrix_RowAt1(t *testing.T) {
+	// Constants
+	skm := symbolic.NewSparseKMatrixFromTriplets(2, 3, []int{0, 1}, []int{2, 0}, []float64{5.0, 6.0})
+
+	// Test
+	row0 := skm.RowAt(0)
+	if row0.Len() != 3 {
+		t.Errorf("Expected row0.Len() to be 3; received %v", row0.Len())
+	}
+	if v := row0.AtVec(2).Constant(); v != 5.0 {
+		t.Errorf("Expected row0.AtVec(2).Constant() to be 5.0; received %v", v)
+	}
+}